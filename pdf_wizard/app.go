@@ -3,14 +3,17 @@ package main
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"pdf_wizard/jobs"
 	"pdf_wizard/models"
 	"pdf_wizard/services"
-
-	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
 // App struct acts as a thin wrapper around services for Wails binding
@@ -18,11 +21,31 @@ type App struct {
 	ctx         context.Context
 	fileService *services.FileService
 	pdfService  *services.PDFService
+	jobs        *jobs.Manager
+	events      services.EventEmitter // Events to the frontend; a no-op until Wails starts
+	configMu    sync.Mutex            // Serializes config file updates
+	recovered   []string              // Files restored at startup after an interrupted operation
 }
 
 const (
-	configFileName  = "pdf_wizard_config.json"
-	defaultLanguage = "en"
+	configFileName        = "pdf_wizard_config.json"
+	defaultLanguage       = "en"
+	defaultConflictPolicy = models.ConflictPolicyOverwrite
+
+	// progressEventName is the frontend event carrying models.ProgressEvent payloads
+	progressEventName = "operation-progress"
+
+	// jobEventName is the frontend event carrying models.Job payloads on every status change
+	jobEventName = "job-updated"
+
+	// defaultMaxConcurrentJobs is the number of PDF operations run in parallel
+	defaultMaxConcurrentJobs = 2
+
+	// maxOutputDirectories is the number of recent output directories kept in the config
+	maxOutputDirectories = 10
+
+	// staleTempFileAge is how old a temporary output file must be to be removed at startup
+	staleTempFileAge = time.Hour
 )
 
 // validLanguages is the single source of truth for supported languages
@@ -43,7 +66,9 @@ var validLanguages = map[string]bool{
 
 // NewApp creates a new App application struct
 func NewApp() *App {
-	return &App{}
+	app := &App{events: services.NoopEventEmitter{}}
+	app.jobs = jobs.NewManager(defaultMaxConcurrentJobs, app.emitJobUpdate)
+	return app
 }
 
 // startup is called when the app starts. The context is saved
@@ -52,24 +77,62 @@ func (a *App) startup(ctx context.Context) {
 	// Save context for runtime operations
 	a.ctx = ctx
 
-	// Initialize services with context
-	fileService := services.NewFileService(ctx)
-	pdfService := services.NewPDFService(fileService)
+	// Initialize services; file dialogs go through the Wails runtime
+	fileService := services.NewFileService(services.NewWailsDialogProvider(ctx))
+	pdfService := services.NewPDFService(fileService, services.ProgressReporterFunc(a.emitProgress))
 
 	a.fileService = fileService
 	a.pdfService = pdfService
+
+	// Restore and remove files left behind by operations that crashed
+	a.recoverOutputDirectories()
+}
+
+// wailsStartup is the Wails OnStartup hook. Events are only emitted through
+// the Wails runtime here, since it needs the context Wails created.
+func (a *App) wailsStartup(ctx context.Context) {
+	a.events = services.NewWailsEventEmitter(ctx)
+	a.startup(ctx)
+}
+
+// emitProgress forwards operation progress to the frontend
+func (a *App) emitProgress(event models.ProgressEvent) {
+	a.events.Emit(progressEventName, event)
+}
+
+// emitJobUpdate forwards job status changes to the frontend
+func (a *App) emitJobUpdate(job models.Job) {
+	a.events.Emit(jobEventName, job)
 }
 
 // EmitSettingsEvent emits an event to show the settings dialog
 func (a *App) EmitSettingsEvent() {
-	if a.ctx != nil {
-		runtime.EventsEmit(a.ctx, "show-settings")
-	}
+	a.events.Emit("show-settings")
+}
+
+// CancelOperation cancels a queued or running PDF operation by the operation ID
+// reported in its progress events (the job ID)
+func (a *App) CancelOperation(operationID string) error {
+	return a.jobs.Cancel(operationID)
+}
+
+// ListJobs returns all queued, running and recently finished jobs
+func (a *App) ListJobs() []models.Job {
+	return a.jobs.List()
 }
 
+// GetJob returns the current state of a job
+func (a *App) GetJob(jobID string) (models.Job, error) {
+	return a.jobs.Get(jobID)
+}
+
+// userConfigDir returns the directory holding the config directory. Tests
+// replace it so they never touch the user's config.
+var userConfigDir = os.UserConfigDir
+
 // getConfigPath returns the path to the config file
 func (a *App) getConfigPath() (string, error) {
-	userConfigDir, err := os.UserConfigDir()
+	userConfigDir, err := userConfigDir()
 	if err != nil {
 		return "", err
 	}
@@ -84,24 +147,67 @@ func (a *App) getConfigPath() (string, error) {
 // Config represents the application configuration
 type Config struct {
 	Language string `json:"language"`
+	// ConflictPolicy is the default models.ConflictPolicy for existing outputs
+	ConflictPolicy string `json:"conflictPolicy,omitempty"`
+	// OutputDirectories are the most recently used output directories, newest
+	// first. They are checked for stale temporary files at startup.
+	OutputDirectories []string `json:"outputDirectories,omitempty"`
 }
 
-// GetLanguage returns the current language setting (default: "en")
-func (a *App) GetLanguage() (string, error) {
+// loadConfig reads the config file
+func (a *App) loadConfig() (Config, error) {
+	var config Config
 	configPath, err := a.getConfigPath()
 	if err != nil {
-		return defaultLanguage, nil // Return default on error
+		return config, err
 	}
-
-	// Read config file
 	data, err := os.ReadFile(configPath)
 	if err != nil {
-		// File doesn't exist, return default
-		return defaultLanguage, nil
+		return config, err
 	}
-
-	var config Config
 	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// saveConfig writes the config file
+func (a *App) saveConfig(config Config) error {
+	configPath, err := a.getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(configPath, data, services.DefaultFilePerm)
+}
+
+// updateConfig applies update to the stored config and saves it. A missing or
+// invalid config file is replaced; an unchanged config is not written again,
+// since every job records its output directory.
+func (a *App) updateConfig(update func(config *Config)) error {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+
+	config, err := a.loadConfig()
+	before := config
+	before.OutputDirectories = slices.Clone(config.OutputDirectories)
+	update(&config)
+	if err == nil && reflect.DeepEqual(before, config) {
+		return nil
+	}
+	return a.saveConfig(config)
+}
+
+// GetLanguage returns the current language setting (default: "en")
+func (a *App) GetLanguage() (string, error) {
+	config, err := a.loadConfig()
+	if err != nil {
+		// Missing or invalid config file, return default
 		return defaultLanguage, nil
 	}
 
@@ -121,24 +227,80 @@ func (a *App) GetLanguage() (string, error) {
 func (a *App) SetLanguage(language string) error {
 	// Validate language code
 	if !validLanguages[language] {
-		return fmt.Errorf("invalid language code: %s", language)
+		return services.NewError(services.ErrCodeInvalidLanguage, services.ErrorParams{"language": language}, nil)
 	}
 
-	configPath, err := a.getConfigPath()
-	if err != nil {
+	return a.updateConfig(func(config *Config) {
+		config.Language = language
+	})
+}
+
+// GetConflictPolicy returns the default conflict policy for existing output
+// files (default: "overwrite")
+func (a *App) GetConflictPolicy() (string, error) {
+	config, err := a.loadConfig()
+	if err != nil || config.ConflictPolicy == "" || services.ValidateConflictPolicy(config.ConflictPolicy) != nil {
+		return defaultConflictPolicy, nil
+	}
+	return config.ConflictPolicy, nil
+}
+
+// SetConflictPolicy saves the default conflict policy used when an operation
+// is started without one
+func (a *App) SetConflictPolicy(policy string) error {
+	if policy == "" {
+		return services.NewError(services.ErrCodeConflictPolicy, services.ErrorParams{"policy": policy}, nil)
+	}
+	if err := services.ValidateConflictPolicy(policy); err != nil {
 		return err
 	}
+	return a.updateConfig(func(config *Config) {
+		config.ConflictPolicy = policy
+	})
+}
 
-	config := Config{
-		Language: language,
+// rememberOutputDirectory records dir as the most recently used output directory
+func (a *App) rememberOutputDirectory(dir string) {
+	if dir == "" {
+		return
 	}
+	dir = filepath.Clean(dir)
+	a.updateConfig(func(config *Config) {
+		dirs := []string{dir}
+		for _, existing := range config.OutputDirectories {
+			if existing != dir && len(dirs) < maxOutputDirectories {
+				dirs = append(dirs, existing)
+			}
+		}
+		config.OutputDirectories = dirs
+	})
+}
 
-	data, err := json.MarshalIndent(config, "", "  ")
+// recoverOutputDirectories cleans up after operations that crashed in the
+// recent output directories: files a split moved aside are restored and
+// leftover temporary outputs are removed. The restored files are kept for
+// GetRecoveredFiles.
+func (a *App) recoverOutputDirectories() {
+	config, err := a.loadConfig()
 	if err != nil {
-		return err
+		return
+	}
+	a.recovered = nil
+	for _, dir := range config.OutputDirectories {
+		// Errors are not reported: a directory that cannot be read (usually one
+		// that was deleted since) has nothing to recover, and backups or
+		// temporary files that could not be handled stay in place and are
+		// tried again at the next startup
+		restored, _ := services.RestoreBackupFiles(dir)
+		a.recovered = append(a.recovered, restored...)
+		services.RemoveStaleTempFiles(dir, staleTempFileAge)
 	}
+}
 
-	return os.WriteFile(configPath, data, services.DefaultFilePerm)
+// GetRecoveredFiles returns the files restored at startup because an
+// operation was interrupted while replacing them, so the user can check them
+func (a *App) GetRecoveredFiles() []string {
+	return append([]string{}, a.recovered...)
 }
 
 // SelectPDFFiles opens a file dialog to select multiple PDF files
@@ -171,22 +333,307 @@ func (a *App) GetPDFMetadata(path string) (models.PDFMetadata, error) {
 	return a.fileService.GetPDFMetadata(path)
 }
 
+// SetPDFPassword remembers the password of a password-protected PDF for this
+// session; an empty password forgets it
+func (a *App) SetPDFPassword(path string, password string) error {
+	return a.fileService.SetPDFPassword(path, password)
+}
+
 // MergePDFs merges the given PDF files in order and saves to output directory
-func (a *App) MergePDFs(inputPaths []string, outputDirectory string, outputFilename string) error {
-	return a.pdfService.MergePDFs(inputPaths, outputDirectory, outputFilename)
+func (a *App) MergePDFs(inputPaths []string, outputDirectory string, outputFilename string, merge models.MergeOptions, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitMergeJob(inputPaths, outputDirectory, outputFilename, merge, options))
+}
+
+// MergePDFPages merges the selected pages of each input in order
+func (a *App) MergePDFPages(inputs []models.MergeInput, outputDirectory string, outputFilename string, merge models.MergeOptions, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitMergePagesJob(inputs, outputDirectory, outputFilename, merge, options))
+}
+
+// CollatePDFs interleaves the pages of a fronts scan and a backs scan into one PDF
+func (a *App) CollatePDFs(collate models.CollateDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitCollateJob(collate, outputDirectory, outputFilename, options))
 }
 
 // SplitPDF splits the given PDF according to split definitions
-func (a *App) SplitPDF(inputPath string, splits []models.SplitDefinition, outputDirectory string) error {
-	return a.pdfService.SplitPDF(inputPath, splits, outputDirectory)
+func (a *App) SplitPDF(inputPath string, splits []models.SplitDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitSplitJob(inputPath, splits, outputDirectory, options))
+}
+
+// GenerateSplitsEvery returns the split definitions that cut a PDF into chunks of a fixed page count
+func (a *App) GenerateSplitsEvery(inputPath string, every models.SplitEveryDefinition) ([]models.SplitDefinition, error) {
+	return a.pdfService.GenerateSplitsEvery(inputPath, every)
+}
+
+// SplitPDFEvery splits the given PDF into chunks of a fixed page count, one file per page for 1
+func (a *App) SplitPDFEvery(inputPath string, every models.SplitEveryDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitSplitEveryJob(inputPath, every, outputDirectory, options))
+}
+
+// GenerateSplitsByOutline returns one split definition per bookmark at an outline level
+func (a *App) GenerateSplitsByOutline(inputPath string, outline models.SplitOutlineDefinition) ([]models.SplitDefinition, error) {
+	return a.pdfService.GenerateSplitsByOutline(inputPath, outline)
+}
+
+// SplitPDFByOutline splits the given PDF into one file per bookmark at an outline level
+func (a *App) SplitPDFByOutline(inputPath string, outline models.SplitOutlineDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitSplitOutlineJob(inputPath, outline, outputDirectory, options))
+}
+
+// GenerateSplitsBySize returns the split definitions that cut a PDF into chunks below a file size.
+// Measuring is slow, so it runs as a job that writes nothing, reports measuring progress under its
+// job ID and can be cancelled with CancelOperation. It waits for the job instead of returning the
+// ID because the caller needs the definitions, which a job's OperationResult does not carry.
+func (a *App) GenerateSplitsBySize(inputPath string, size models.SplitSizeDefinition) ([]models.SplitDefinition, error) {
+	var splits []models.SplitDefinition
+	_, err := a.jobs.Run(services.OperationSplit, nil, func(ctx context.Context) (models.OperationResult, error) {
+		var err error
+		splits, err = a.pdfService.GenerateSplitsBySize(ctx, inputPath, size)
+		return models.OperationResult{}, err
+	})
+	return splits, err
+}
+
+// SplitPDFBySize splits the given PDF into consecutive chunks below a file size
+func (a *App) SplitPDFBySize(inputPath string, size models.SplitSizeDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitSplitSizeJob(inputPath, size, outputDirectory, options))
+}
+
+// ExtractPages copies the selected pages of a PDF file, in the given order, into a new PDF file
+func (a *App) ExtractPages(inputPath string, pageRange string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitExtractJob(inputPath, pageRange, outputDirectory, outputFilename, options))
+}
+
+// DeletePages writes a copy of a PDF file without the selected pages
+func (a *App) DeletePages(inputPath string, pageRange string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitDeleteJob(inputPath, pageRange, outputDirectory, outputFilename, options))
+}
+
+// ReorderPages writes a copy of a PDF file with its pages in a new order
+func (a *App) ReorderPages(inputPath string, reorder models.ReorderDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitReorderJob(inputPath, reorder, outputDirectory, outputFilename, options))
+}
+
+// InsertPages writes a copy of a PDF file with blank pages or pages of another PDF inserted
+func (a *App) InsertPages(inputPath string, insert models.InsertDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitInsertJob(inputPath, insert, outputDirectory, outputFilename, options))
 }
 
 // RotatePDF rotates specified page ranges in a PDF file
-func (a *App) RotatePDF(inputPath string, rotations []models.RotateDefinition, outputDirectory string, outputFilename string) error {
-	return a.pdfService.RotatePDF(inputPath, rotations, outputDirectory, outputFilename)
+func (a *App) RotatePDF(inputPath string, rotations []models.RotateDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitRotateJob(inputPath, rotations, outputDirectory, outputFilename, options))
 }
 
 // ApplyWatermark applies a text watermark to the specified PDF file
-func (a *App) ApplyWatermark(inputPath string, watermark models.WatermarkDefinition, outputDirectory string, outputFilename string) error {
-	return a.pdfService.ApplyWatermark(inputPath, watermark, outputDirectory, outputFilename)
+func (a *App) ApplyWatermark(inputPath string, watermark models.WatermarkDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitWatermarkJob(inputPath, watermark, outputDirectory, outputFilename, options))
+}
+
+// EncryptPDF password-protects a PDF file
+func (a *App) EncryptPDF(inputPath string, encryption models.EncryptionDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitEncryptJob(inputPath, encryption, outputDirectory, outputFilename, options))
+}
+
+// OptimizePDF writes a smaller copy of a PDF file
+func (a *App) OptimizePDF(inputPath string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitOptimizeJob(inputPath, outputDirectory, outputFilename, options))
+}
+
+// DecryptPDF writes an unencrypted copy of a password-protected PDF file
+func (a *App) DecryptPDF(inputPath string, password string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitDecryptJob(inputPath, password, outputDirectory, outputFilename, options))
+}
+
+// ChangePermissions changes the permissions and/or passwords of an encrypted PDF file
+func (a *App) ChangePermissions(inputPath string, change models.PermissionChange, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	return a.jobs.Wait(a.SubmitPermissionsJob(inputPath, change, outputDirectory, outputFilename, options))
+}
+
+// SubmitMergeJob queues a merge and returns its job ID without waiting for it
+func (a *App) SubmitMergeJob(inputPaths []string, outputDirectory string, outputFilename string, merge models.MergeOptions, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationMerge, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.MergePDFs(ctx, inputPaths, outputDirectory, outputFilename, merge, options)
+	})
+}
+
+// SubmitMergePagesJob queues a merge of selected pages and returns its job ID without waiting for it
+func (a *App) SubmitMergePagesJob(inputs []models.MergeInput, outputDirectory string, outputFilename string, merge models.MergeOptions, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationMerge, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.MergePDFPages(ctx, inputs, outputDirectory, outputFilename, merge, options)
+	})
+}
+
+// SubmitCollateJob queues a collation and returns its job ID without waiting for it
+func (a *App) SubmitCollateJob(collate models.CollateDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationCollate, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.CollatePDFs(ctx, collate, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitSplitJob queues a split and returns its job ID without waiting for it
+func (a *App) SubmitSplitJob(inputPath string, splits []models.SplitDefinition, outputDirectory string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := splitJobOutputs(outputDirectory, splits)
+	return a.submitJob(services.OperationSplit, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.SplitPDF(ctx, inputPath, splits, outputDirectory, options)
+	})
+}
+
+// SubmitSplitEveryJob queues a split into fixed-size chunks and returns its job ID without waiting for it
+func (a *App) SubmitSplitEveryJob(inputPath string, every models.SplitEveryDefinition, outputDirectory string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	// The splits are generated by the job, so it locks the whole output directory
+	outputs := []string{outputDirectory}
+	return a.submitJob(services.OperationSplit, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.SplitPDFEvery(ctx, inputPath, every, outputDirectory, options)
+	})
+}
+
+// SubmitSplitOutlineJob queues a split by bookmarks and returns its job ID without waiting for it
+func (a *App) SubmitSplitOutlineJob(inputPath string, outline models.SplitOutlineDefinition, outputDirectory string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	// The splits are generated by the job, so it locks the whole output directory
+	outputs := []string{outputDirectory}
+	return a.submitJob(services.OperationSplit, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.SplitPDFByOutline(ctx, inputPath, outline, outputDirectory, options)
+	})
+}
+
+// SubmitSplitSizeJob queues a split by file size and returns its job ID without waiting for it
+func (a *App) SubmitSplitSizeJob(inputPath string, size models.SplitSizeDefinition, outputDirectory string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	// The chunks are only known once the job has measured them, so it locks the whole output directory
+	outputs := []string{outputDirectory}
+	return a.submitJob(services.OperationSplit, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.SplitPDFBySize(ctx, inputPath, size, outputDirectory, options)
+	})
+}
+
+// SubmitExtractJob queues a page extraction and returns its job ID without waiting for it
+func (a *App) SubmitExtractJob(inputPath string, pageRange string, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationExtract, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.ExtractPages(ctx, inputPath, pageRange, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitDeleteJob queues a page deletion and returns its job ID without waiting for it
+func (a *App) SubmitDeleteJob(inputPath string, pageRange string, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationDelete, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.DeletePages(ctx, inputPath, pageRange, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitReorderJob queues a page reordering and returns its job ID without waiting for it
+func (a *App) SubmitReorderJob(inputPath string, reorder models.ReorderDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationReorder, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.ReorderPages(ctx, inputPath, reorder, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitInsertJob queues a page insertion and returns its job ID without waiting for it
+func (a *App) SubmitInsertJob(inputPath string, insert models.InsertDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationInsert, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.InsertPages(ctx, inputPath, insert, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitRotateJob queues a rotation and returns its job ID without waiting for it
+func (a *App) SubmitRotateJob(inputPath string, rotations []models.RotateDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationRotate, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.RotatePDF(ctx, inputPath, rotations, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitWatermarkJob queues a watermark and returns its job ID without waiting for it
+func (a *App) SubmitWatermarkJob(inputPath string, watermark models.WatermarkDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationWatermark, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.ApplyWatermark(ctx, inputPath, watermark, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitEncryptJob queues an encryption and returns its job ID without waiting for it
+func (a *App) SubmitEncryptJob(inputPath string, encryption models.EncryptionDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationEncrypt, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.EncryptPDF(ctx, inputPath, encryption, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitOptimizeJob queues an optimization and returns its job ID without waiting for it
+func (a *App) SubmitOptimizeJob(inputPath string, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationOptimize, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.OptimizePDF(ctx, inputPath, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitDecryptJob queues a decryption and returns its job ID without waiting for it
+func (a *App) SubmitDecryptJob(inputPath string, password string, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationDecrypt, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.DecryptPDF(ctx, inputPath, password, outputDirectory, outputFilename, options)
+	})
+}
+
+// SubmitPermissionsJob queues a permission change and returns its job ID without waiting for it
+func (a *App) SubmitPermissionsJob(inputPath string, change models.PermissionChange, outputDirectory string, outputFilename string, options models.OutputOptions) string {
+	options = a.outputOptions(options)
+	outputs := []string{jobOutputPath(outputDirectory, outputFilename)}
+	return a.submitJob(services.OperationPermissions, outputDirectory, outputs, options, func(ctx context.Context) (models.OperationResult, error) {
+		return a.pdfService.ChangePermissions(ctx, inputPath, change, outputDirectory, outputFilename, options)
+	})
+}
+
+// outputOptions fills in the saved default conflict policy if options has none
+func (a *App) outputOptions(options models.OutputOptions) models.OutputOptions {
+	if options.ConflictPolicy == "" {
+		options.ConflictPolicy, _ = a.GetConflictPolicy()
+	}
+	return options
+}
+
+// submitJob queues task and remembers its output directory for the startup cleanup.
+// A renamed output's name is only chosen when the job runs, so jobs with the
+// rename policy lock the whole output directory instead of their outputs.
+func (a *App) submitJob(operation, outputDirectory string, outputs []string, options models.OutputOptions, task jobs.Task) string {
+	a.rememberOutputDirectory(outputDirectory)
+	if options.ConflictPolicy == models.ConflictPolicyRename {
+		outputs = []string{outputDirectory}
+	}
+	return a.jobs.Submit(operation, outputs, task)
+}
+
+// splitJobOutputs returns the files a split writes
+func splitJobOutputs(outputDirectory string, splits []models.SplitDefinition) []string {
+	outputs := make([]string, 0, len(splits))
+	for _, split := range splits {
+		outputs = append(outputs, jobOutputPath(outputDirectory, strings.TrimSpace(split.Filename)))
+	}
+	return outputs
+}
+
+// jobOutputPath returns the file an operation writes, used to serialize jobs
+// sharing an output. Invalid names are left for the service to reject.
+func jobOutputPath(outputDirectory, outputFilename string) string {
+	return filepath.Join(outputDirectory, outputFilename+services.PDFExtension)
 }
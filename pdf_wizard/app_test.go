@@ -2,16 +2,22 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
 	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
 
+	"pdf_wizard/jobs"
 	"pdf_wizard/models"
+	"pdf_wizard/services"
 )
 
 // createTestPDF creates a minimal valid PDF file for testing
@@ -84,6 +90,22 @@ startxref
 	return os.WriteFile(path, []byte(pdfContent), 0644)
 }
 
+// TestMain points the config file at a temporary directory. The tests must
+// not touch the user's config: its output directories are swept at startup.
+func TestMain(m *testing.M) {
+	configDir, err := os.MkdirTemp("", "pdf_wizard_config_*")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create config dir: %v\n", err)
+		os.Exit(1)
+	}
+	userConfigDir = func() (string, error) {
+		return configDir, nil
+	}
+	code := m.Run()
+	os.RemoveAll(configDir)
+	os.Exit(code)
+}
+
 // setupTestDir creates a temporary directory for tests
 func setupTestDir(t *testing.T) string {
 	dir, err := os.MkdirTemp("", "pdf_wizard_test_*")
@@ -202,7 +224,7 @@ func TestMergePDFs(t *testing.T) {
 	outputFilename := "merged"
 
 	// Test MergePDFs
-	err := app.MergePDFs([]string{pdf1, pdf2, pdf3}, outputDir, outputFilename)
+	_, err := app.MergePDFs([]string{pdf1, pdf2, pdf3}, outputDir, outputFilename, models.MergeOptions{}, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("MergePDFs failed: %v", err)
 	}
@@ -229,6 +251,56 @@ func TestMergePDFs(t *testing.T) {
 	}
 }
 
+func TestMergePDFPages(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf1 := filepath.Join(testDir, "test1.pdf")
+	pdf2 := filepath.Join(testDir, "test2.pdf")
+	for _, path := range []string{pdf1, pdf2} {
+		if err := createMultiPageTestPDF(path, 3); err != nil {
+			t.Fatalf("Failed to create multi-page test PDF: %v", err)
+		}
+	}
+
+	inputs := []models.MergeInput{{Path: pdf1, Pages: "1-2"}, {Path: pdf2, Pages: "last"}}
+	result, err := app.MergePDFPages(inputs, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("MergePDFPages failed: %v", err)
+	}
+	if result.Operation != "merge" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+func TestCollatePDFs(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	fronts := filepath.Join(testDir, "fronts.pdf")
+	backs := filepath.Join(testDir, "backs.pdf")
+	for _, path := range []string{fronts, backs} {
+		if err := createMultiPageTestPDF(path, 3); err != nil {
+			t.Fatalf("Failed to create multi-page test PDF: %v", err)
+		}
+	}
+
+	collate := models.CollateDefinition{FrontsPath: fronts, BacksPath: backs, ReverseBacks: true}
+	result, err := app.CollatePDFs(collate, testDir, "collated", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("CollatePDFs failed: %v", err)
+	}
+	if result.Operation != "collate" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 6 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
 func TestMergePDFs_EmptyInput(t *testing.T) {
 	app := NewApp()
 	app.startup(context.Background())
@@ -236,7 +308,7 @@ func TestMergePDFs_EmptyInput(t *testing.T) {
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
 
-	err := app.MergePDFs([]string{}, testDir, "output")
+	_, err := app.MergePDFs([]string{}, testDir, "output", models.MergeOptions{}, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for empty input, got nil")
 	}
@@ -252,7 +324,7 @@ func TestMergePDFs_NonExistentFile(t *testing.T) {
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
 
-	err := app.MergePDFs([]string{"/nonexistent/file.pdf"}, testDir, "output")
+	_, err := app.MergePDFs([]string{"/nonexistent/file.pdf"}, testDir, "output", models.MergeOptions{}, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-existent file, got nil")
 	}
@@ -271,7 +343,7 @@ func TestMergePDFs_NonPDFFile(t *testing.T) {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
-	err := app.MergePDFs([]string{testFile}, testDir, "output")
+	_, err := app.MergePDFs([]string{testFile}, testDir, "output", models.MergeOptions{}, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-PDF file, got nil")
 	}
@@ -291,7 +363,7 @@ func TestMergePDFs_NonExistentOutputDir(t *testing.T) {
 	}
 
 	nonExistentDir := filepath.Join(testDir, "nonexistent")
-	err := app.MergePDFs([]string{testPDF}, nonExistentDir, "output")
+	_, err := app.MergePDFs([]string{testPDF}, nonExistentDir, "output", models.MergeOptions{}, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-existent output directory, got nil")
 	}
@@ -323,7 +395,7 @@ func TestMergePDFs_OverwriteExisting(t *testing.T) {
 	}
 
 	// Test that merge overwrites the existing file
-	err := app.MergePDFs([]string{pdf1, pdf2}, testDir, "merged")
+	_, err := app.MergePDFs([]string{pdf1, pdf2}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("MergePDFs failed: %v", err)
 	}
@@ -559,7 +631,7 @@ func TestSplitPDF(t *testing.T) {
 	}
 
 	// Test SplitPDF
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("SplitPDF failed: %v", err)
 	}
@@ -591,6 +663,184 @@ func TestSplitPDF(t *testing.T) {
 	}
 }
 
+func TestSplitPDFEvery(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 10); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	every := models.SplitEveryDefinition{PagesPerFile: 4}
+	splits, err := app.GenerateSplitsEvery(inputPDF, every)
+	if err != nil {
+		t.Fatalf("GenerateSplitsEvery failed: %v", err)
+	}
+	if len(splits) != 3 || splits[2].StartPage != 9 || splits[2].EndPage != 10 || splits[2].Filename != "input_003" {
+		t.Errorf("Unexpected splits: %+v", splits)
+	}
+
+	result, err := app.SplitPDFEvery(inputPDF, every, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFEvery failed: %v", err)
+	}
+	if result.Operation != "split" || len(result.Outputs) != 3 || result.Outputs[2].PageCount != 2 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	// The splits are generated by the job, which holds the output directory
+	jobID := app.SubmitSplitEveryJob(inputPDF, every, testDir, models.OutputOptions{})
+	job, err := app.GetJob(jobID)
+	if err != nil {
+		t.Fatalf("GetJob failed: %v", err)
+	}
+	if len(job.Outputs) != 1 || job.Outputs[0] != testDir {
+		t.Errorf("Expected the job to lock %s, got %v", testDir, job.Outputs)
+	}
+	if _, err := app.jobs.Wait(jobID); err != nil {
+		t.Errorf("Split job failed: %v", err)
+	}
+}
+
+func TestSplitPDFByOutline(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 5); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+	bookmarks := []pdfcpu.Bookmark{{Title: "Chapter 1", PageFrom: 1}, {Title: "Chapter 2", PageFrom: 3}}
+	if err := api.AddBookmarksFile(inputPDF, "", bookmarks, true, nil); err != nil {
+		t.Fatalf("Failed to add bookmarks: %v", err)
+	}
+
+	outline := models.SplitOutlineDefinition{Level: 1}
+	splits, err := app.GenerateSplitsByOutline(inputPDF, outline)
+	if err != nil {
+		t.Fatalf("GenerateSplitsByOutline failed: %v", err)
+	}
+	if len(splits) != 2 || splits[1].StartPage != 3 || splits[1].EndPage != 5 || splits[1].Filename != "Chapter 2" {
+		t.Errorf("Unexpected splits: %+v", splits)
+	}
+
+	result, err := app.SplitPDFByOutline(inputPDF, outline, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFByOutline failed: %v", err)
+	}
+	if result.Operation != "split" || len(result.Outputs) != 2 || result.Outputs[0].PageCount != 2 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	// The splits are generated by the job, which holds the output directory
+	jobID := app.SubmitSplitOutlineJob(inputPDF, outline, testDir, models.OutputOptions{})
+	job, err := app.GetJob(jobID)
+	if err != nil {
+		t.Fatalf("GetJob failed: %v", err)
+	}
+	if len(job.Outputs) != 1 || job.Outputs[0] != testDir {
+		t.Errorf("Expected the job to lock %s, got %v", testDir, job.Outputs)
+	}
+	if _, err := app.jobs.Wait(jobID); err != nil {
+		t.Errorf("Split job failed: %v", err)
+	}
+}
+
+func TestSplitPDFBySize(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 4); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	// A limit above the whole file keeps every page in one chunk
+	info, err := os.Stat(inputPDF)
+	if err != nil {
+		t.Fatalf("Failed to stat input: %v", err)
+	}
+	size := models.SplitSizeDefinition{MaxBytes: 10 * info.Size()}
+	splits, err := app.GenerateSplitsBySize(inputPDF, size)
+	if err != nil {
+		t.Fatalf("GenerateSplitsBySize failed: %v", err)
+	}
+	if len(splits) != 1 || splits[0].StartPage != 1 || splits[0].EndPage != 4 {
+		t.Errorf("Unexpected splits: %+v", splits)
+	}
+
+	result, err := app.SplitPDFBySize(inputPDF, size, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFBySize failed: %v", err)
+	}
+	if result.Operation != "split" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 4 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	if _, err := app.SplitPDFBySize(inputPDF, models.SplitSizeDefinition{MaxBytes: 10}, testDir, models.OutputOptions{}); services.ErrorCodeOf(err) != services.ErrCodeSplitPageTooLarge {
+		t.Errorf("Expected %s, got %v", services.ErrCodeSplitPageTooLarge, err)
+	}
+
+	// The split locks its output directory, so it waits for a job writing a file in it
+	release := make(chan struct{})
+	blocker := app.jobs.Submit(services.OperationMerge, []string{filepath.Join(testDir, "input_001.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		<-release
+		return models.OperationResult{}, nil
+	})
+	jobID := app.SubmitSplitSizeJob(inputPDF, size, testDir, models.OutputOptions{})
+	time.Sleep(50 * time.Millisecond)
+	if job, err := app.GetJob(jobID); err != nil || job.Status != models.JobStatusQueued {
+		t.Errorf("Expected the split to wait for the output directory, got %+v (%v)", job, err)
+	}
+	close(release)
+	if _, err := app.jobs.Wait(blocker); err != nil {
+		t.Fatalf("Blocking job failed: %v", err)
+	}
+	if _, err := app.jobs.Wait(jobID); err != nil {
+		t.Errorf("Split job failed: %v", err)
+	}
+}
+
+func TestGenerateSplitsBySize_RunsAsCancellableJob(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 4); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	// Measuring runs as a job, so the frontend learns its ID from the job
+	// events and can cancel it
+	var jobID string
+	app.jobs = jobs.NewManager(1, func(job models.Job) {
+		if job.Status == models.JobStatusRunning && jobID == "" {
+			jobID = job.ID
+			app.CancelOperation(job.ID)
+		}
+	})
+	_, err := app.GenerateSplitsBySize(inputPDF, models.SplitSizeDefinition{MaxBytes: 1 << 20})
+	if services.ErrorCodeOf(err) != services.ErrCodeCancelled {
+		t.Fatalf("Expected %s, got %v", services.ErrCodeCancelled, err)
+	}
+	if job, err := app.GetJob(jobID); err != nil || job.Status != models.JobStatusCancelled || len(job.Outputs) != 0 {
+		t.Errorf("Expected a cancelled job without outputs, got %+v (%v)", job, err)
+	}
+}
+
 func TestSplitPDF_SinglePageSplit(t *testing.T) {
 	app := NewApp()
 	app.startup(context.Background())
@@ -615,7 +865,7 @@ func TestSplitPDF_SinglePageSplit(t *testing.T) {
 	}
 
 	// Test SplitPDF
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("SplitPDF failed: %v", err)
 	}
@@ -656,7 +906,7 @@ func TestSplitPDF_InvalidPageRange(t *testing.T) {
 	}
 
 	// Test SplitPDF should fail
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for invalid page range, got nil")
 	}
@@ -686,7 +936,7 @@ func TestSplitPDF_EndPageLessThanStartPage(t *testing.T) {
 	}
 
 	// Test SplitPDF should fail
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for end page less than start page, got nil")
 	}
@@ -716,7 +966,7 @@ func TestSplitPDF_EmptyFilename(t *testing.T) {
 	}
 
 	// Test SplitPDF should fail
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for empty filename, got nil")
 	}
@@ -747,7 +997,7 @@ func TestSplitPDF_DuplicateFilenames(t *testing.T) {
 	}
 
 	// Test SplitPDF should fail
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for duplicate filenames, got nil")
 	}
@@ -770,7 +1020,7 @@ func TestSplitPDF_NonExistentInputFile(t *testing.T) {
 	}
 
 	// Test SplitPDF should fail
-	err := app.SplitPDF("/nonexistent/file.pdf", splits, outputDir)
+	_, err := app.SplitPDF("/nonexistent/file.pdf", splits, outputDir, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-existent input file, got nil")
 	}
@@ -795,7 +1045,7 @@ func TestSplitPDF_NonExistentOutputDir(t *testing.T) {
 	}
 
 	// Test SplitPDF should fail
-	err := app.SplitPDF(inputPDF, splits, nonExistentDir)
+	_, err := app.SplitPDF(inputPDF, splits, nonExistentDir, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-existent output directory, got nil")
 	}
@@ -830,7 +1080,7 @@ func TestSplitPDF_OverwriteExisting(t *testing.T) {
 	}
 
 	// Test SplitPDF should overwrite existing file
-	err := app.SplitPDF(inputPDF, splits, outputDir)
+	_, err := app.SplitPDF(inputPDF, splits, outputDir, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("SplitPDF failed: %v", err)
 	}
@@ -874,7 +1124,7 @@ func TestRotatePDF(t *testing.T) {
 	}
 
 	// Test RotatePDF
-	err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename)
+	_, err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("RotatePDF failed: %v", err)
 	}
@@ -923,7 +1173,7 @@ func TestRotatePDF_InvalidPageRange(t *testing.T) {
 	}
 
 	// Test RotatePDF should fail
-	err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename)
+	_, err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for invalid page range, got nil")
 	}
@@ -951,7 +1201,7 @@ func TestRotatePDF_InvalidRotationAngle(t *testing.T) {
 	}
 
 	// Test RotatePDF should fail
-	err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename)
+	_, err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for invalid rotation angle, got nil")
 	}
@@ -976,7 +1226,7 @@ func TestRotatePDF_EmptyFilename(t *testing.T) {
 	}
 
 	// Test RotatePDF should fail
-	err := app.RotatePDF(inputPDF, rotations, outputDir, "")
+	_, err := app.RotatePDF(inputPDF, rotations, outputDir, "", models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for empty filename, got nil")
 	}
@@ -1006,7 +1256,7 @@ func TestRotatePDF_AllRotationAngles(t *testing.T) {
 				{StartPage: 1, EndPage: 3, Rotation: angle},
 			}
 
-			err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename)
+			_, err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename, models.OutputOptions{})
 			if err != nil {
 				t.Fatalf("RotatePDF failed for angle %d: %v", angle, err)
 			}
@@ -1059,7 +1309,7 @@ func TestRotatePDF_MultipleRotationsOnSamePages(t *testing.T) {
 	}
 
 	// Test RotatePDF - should succeed even with overlapping pages
-	err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename)
+	_, err := app.RotatePDF(inputPDF, rotations, outputDir, outputFilename, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("RotatePDF failed with overlapping rotations: %v", err)
 	}
@@ -1097,7 +1347,7 @@ func TestRotatePDF_NonExistentInputFile(t *testing.T) {
 		{StartPage: 1, EndPage: 1, Rotation: 90},
 	}
 
-	err := app.RotatePDF("/nonexistent/file.pdf", rotations, testDir, "output")
+	_, err := app.RotatePDF("/nonexistent/file.pdf", rotations, testDir, "output", models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-existent input file, got nil")
 	}
@@ -1121,7 +1371,7 @@ func TestRotatePDF_NonExistentOutputDir(t *testing.T) {
 		{StartPage: 1, EndPage: 1, Rotation: 90},
 	}
 
-	err := app.RotatePDF(inputPDF, rotations, nonExistentDir, "output")
+	_, err := app.RotatePDF(inputPDF, rotations, nonExistentDir, "output", models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for non-existent output directory, got nil")
 	}
@@ -1152,7 +1402,7 @@ func TestRotatePDF_OverwriteExisting(t *testing.T) {
 	}
 
 	// Test RotatePDF should overwrite existing file
-	err := app.RotatePDF(inputPDF, rotations, testDir, "rotated")
+	_, err := app.RotatePDF(inputPDF, rotations, testDir, "rotated", models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("RotatePDF failed: %v", err)
 	}
@@ -1174,6 +1424,386 @@ func TestRotatePDF_OverwriteExisting(t *testing.T) {
 
 // Language Management Tests
 
+func TestEncryptPDF(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	encryption := models.EncryptionDefinition{
+		UserPassword:  "user",
+		OwnerPassword: "owner",
+		Algorithm:     models.EncryptionAES256,
+		Permissions:   models.PDFPermissions{Print: true},
+	}
+	result, err := app.EncryptPDF(inputPDF, encryption, testDir, "protected", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("EncryptPDF failed: %v", err)
+	}
+	if result.Operation != "encrypt" || len(result.Outputs) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	// Opening the output without a password must fail
+	if _, err := app.GetPDFPageCount(filepath.Join(testDir, "protected.pdf")); err == nil {
+		t.Error("Expected the encrypted PDF to require a password")
+	}
+}
+
+func TestSetPDFPassword(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+	encryption := models.EncryptionDefinition{UserPassword: "user", OwnerPassword: "owner"}
+	if _, err := app.EncryptPDF(inputPDF, encryption, testDir, "protected", models.OutputOptions{}); err != nil {
+		t.Fatalf("EncryptPDF failed: %v", err)
+	}
+	protectedPDF := filepath.Join(testDir, "protected.pdf")
+
+	if err := app.SetPDFPassword(protectedPDF, "wrong"); services.ErrorCodeOf(err) != services.ErrCodeIncorrectPassword {
+		t.Errorf("Expected %s, got %v", services.ErrCodeIncorrectPassword, err)
+	}
+	if err := app.SetPDFPassword(protectedPDF, "owner"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+	metadata, err := app.GetPDFMetadata(protectedPDF)
+	if err != nil {
+		t.Fatalf("GetPDFMetadata failed: %v", err)
+	}
+	if !metadata.Encrypted || metadata.TotalPages != 2 {
+		t.Errorf("Expected an encrypted 2-page PDF, got %+v", metadata)
+	}
+}
+
+func TestOptimizePDF(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	result, err := app.OptimizePDF(inputPDF, testDir, "optimized", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("OptimizePDF failed: %v", err)
+	}
+	if result.Operation != "optimize" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 2 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if result.SizeBefore == 0 || result.SizeAfter != result.Outputs[0].Size {
+		t.Errorf("Expected before/after sizes, got %d/%d", result.SizeBefore, result.SizeAfter)
+	}
+}
+
+func TestExtractPages(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 5); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	result, err := app.ExtractPages(inputPDF, "5,1-2", testDir, "picked", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("ExtractPages failed: %v", err)
+	}
+	if result.Operation != "extract" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+func TestDeletePages(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 5); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	result, err := app.DeletePages(inputPDF, "1,last", testDir, "trimmed", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("DeletePages failed: %v", err)
+	}
+	if result.Operation != "delete" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	for _, pageRange := range []string{"all", "1-5"} {
+		if _, err := app.DeletePages(inputPDF, pageRange, testDir, "empty", models.OutputOptions{}); services.ErrorCodeOf(err) != services.ErrCodeDeleteAllPages {
+			t.Errorf("%s: expected %s, got %v", pageRange, services.ErrCodeDeleteAllPages, err)
+		}
+	}
+}
+
+func TestReorderPages(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	result, err := app.ReorderPages(inputPDF, models.ReorderDefinition{Reverse: true}, testDir, "reversed", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("ReorderPages failed: %v", err)
+	}
+	if result.Operation != "reorder" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+func TestInsertPages(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	insert := models.InsertDefinition{Page: 1, Position: models.PositionAfter, BlankPages: 2}
+	result, err := app.InsertPages(inputPDF, insert, testDir, "separated", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("InsertPages failed: %v", err)
+	}
+	if result.Operation != "insert" || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 5 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+func TestDecryptPDFAndChangePermissions(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+	encryption := models.EncryptionDefinition{UserPassword: "user", OwnerPassword: "owner"}
+	if _, err := app.EncryptPDF(inputPDF, encryption, testDir, "protected", models.OutputOptions{}); err != nil {
+		t.Fatalf("EncryptPDF failed: %v", err)
+	}
+	protectedPDF := filepath.Join(testDir, "protected.pdf")
+
+	change := models.PermissionChange{UserPassword: "user", OwnerPassword: "owner", Permissions: &models.PDFPermissions{Print: true}}
+	result, err := app.ChangePermissions(protectedPDF, change, testDir, "printable", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("ChangePermissions failed: %v", err)
+	}
+	if result.Operation != "permissions" || len(result.Outputs) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	result, err = app.DecryptPDF(filepath.Join(testDir, "printable.pdf"), "owner", testDir, "open", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("DecryptPDF failed: %v", err)
+	}
+	if result.Operation != "decrypt" || len(result.Outputs) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if pageCount, err := app.GetPDFPageCount(filepath.Join(testDir, "open.pdf")); err != nil || pageCount != 2 {
+		t.Errorf("Expected the decrypted PDF to open without a password, got %d pages (%v)", pageCount, err)
+	}
+}
+
+func TestCancelOperation_UnknownID(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	if err := app.CancelOperation("does-not-exist"); err == nil {
+		t.Error("Expected error for unknown operation ID, got nil")
+	}
+}
+
+func TestCancelOperation_CancelsRunningOperation(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	started := make(chan string, 1)
+	jobID := app.jobs.Submit(services.OperationMerge, nil, func(ctx context.Context) (models.OperationResult, error) {
+		started <- services.OperationIDFromContext(ctx)
+		<-ctx.Done()
+		return models.OperationResult{}, ctx.Err()
+	})
+
+	operationID := <-started
+	if operationID != jobID {
+		t.Fatalf("Expected operation ID %s to match job ID %s", operationID, jobID)
+	}
+	if err := app.CancelOperation(operationID); err != nil {
+		t.Fatalf("CancelOperation failed: %v", err)
+	}
+	if _, err := app.jobs.Wait(jobID); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	job, err := app.GetJob(jobID)
+	if err != nil {
+		t.Fatalf("GetJob failed: %v", err)
+	}
+	if job.Status != models.JobStatusCancelled {
+		t.Errorf("Expected status %q, got %q", models.JobStatusCancelled, job.Status)
+	}
+}
+
+// recordingEmitter is an EventEmitter that records the names of emitted events
+type recordingEmitter struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (e *recordingEmitter) Emit(name string, data ...interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.names = append(e.names, name)
+}
+
+func (e *recordingEmitter) count(name string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	n := 0
+	for _, emitted := range e.names {
+		if emitted == name {
+			n++
+		}
+	}
+	return n
+}
+
+func TestEmitEvents(t *testing.T) {
+	app := NewApp()
+	emitter := &recordingEmitter{}
+	app.events = emitter
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf1 := filepath.Join(testDir, "test1.pdf")
+	if err := createTestPDF(pdf1); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	if _, err := app.MergePDFs([]string{pdf1, pdf1}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{}); err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+	app.EmitSettingsEvent()
+
+	if emitter.count(progressEventName) == 0 {
+		t.Errorf("Expected %q events", progressEventName)
+	}
+	// queued and running; the final update may still be on its way
+	if emitter.count(jobEventName) < 2 {
+		t.Errorf("Expected %q events, got %d", jobEventName, emitter.count(jobEventName))
+	}
+	if emitter.count("show-settings") != 1 {
+		t.Errorf("Expected one %q event", "show-settings")
+	}
+}
+
+func TestSubmitMergeJob(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf1 := filepath.Join(testDir, "test1.pdf")
+	pdf2 := filepath.Join(testDir, "test2.pdf")
+	for _, path := range []string{pdf1, pdf2} {
+		if err := createTestPDF(path); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+	}
+
+	jobID := app.SubmitMergeJob([]string{pdf1, pdf2}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
+	if _, err := app.jobs.Wait(jobID); err != nil {
+		t.Fatalf("Merge job failed: %v", err)
+	}
+
+	job, err := app.GetJob(jobID)
+	if err != nil {
+		t.Fatalf("GetJob failed: %v", err)
+	}
+	expectedOutput := filepath.Join(testDir, "merged.pdf")
+	if job.Status != models.JobStatusDone || job.Operation != services.OperationMerge {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+	if len(job.Outputs) != 1 || job.Outputs[0] != expectedOutput {
+		t.Errorf("Expected outputs [%s], got %v", expectedOutput, job.Outputs)
+	}
+	if job.Result == nil || len(job.Result.Outputs) != 1 || job.Result.Outputs[0].PageCount != 2 {
+		t.Errorf("Expected result with one 2-page output, got %+v", job.Result)
+	}
+
+	found := false
+	for _, listed := range app.ListJobs() {
+		if listed.ID == jobID {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Job %s missing from ListJobs", jobID)
+	}
+
+	// A renamed output is only named when the job runs, so the job holds the directory
+	rename := models.OutputOptions{ConflictPolicy: models.ConflictPolicyRename}
+	jobID = app.SubmitMergeJob([]string{pdf1, pdf2}, testDir, "merged", models.MergeOptions{}, rename)
+	result, err := app.jobs.Wait(jobID)
+	if err != nil {
+		t.Fatalf("Renaming merge job failed: %v", err)
+	}
+	if job, _ := app.GetJob(jobID); len(job.Outputs) != 1 || job.Outputs[0] != testDir {
+		t.Errorf("Expected the renaming job to lock %s, got %v", testDir, job.Outputs)
+	}
+	if len(result.Outputs) != 1 || result.Outputs[0].Path != filepath.Join(testDir, "merged (2).pdf") {
+		t.Errorf("Expected merged (2).pdf, got %+v", result.Outputs)
+	}
+}
+
+func TestGetJob_UnknownID(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	if _, err := app.GetJob("does-not-exist"); err == nil {
+		t.Error("Expected error for unknown job ID, got nil")
+	}
+}
+
 func TestGetLanguage_DefaultWhenNoConfig(t *testing.T) {
 	app := NewApp()
 	app.startup(context.Background())
@@ -1399,3 +2029,191 @@ func TestSetLanguage_OverwritesExistingConfig(t *testing.T) {
 		t.Errorf("Expected language 'en', got '%s'", language)
 	}
 }
+
+func TestSetLanguage_KeepsOtherSettings(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	app.rememberOutputDirectory(testDir)
+	if err := app.SetLanguage("fr"); err != nil {
+		t.Fatalf("SetLanguage failed: %v", err)
+	}
+	defer app.SetLanguage(defaultLanguage)
+
+	config, err := app.loadConfig()
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if config.Language != "fr" {
+		t.Errorf("Expected language 'fr', got '%s'", config.Language)
+	}
+	if len(config.OutputDirectories) == 0 || config.OutputDirectories[0] != filepath.Clean(testDir) {
+		t.Errorf("Expected output directory %s to be kept, got %v", testDir, config.OutputDirectories)
+	}
+}
+
+func TestRememberOutputDirectory_MostRecentFirst(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	var dirs []string
+	for i := 0; i < maxOutputDirectories+2; i++ {
+		dirs = append(dirs, filepath.Join(os.TempDir(), fmt.Sprintf("pdf_wizard_output_%d", i)))
+		app.rememberOutputDirectory(dirs[i])
+	}
+	// Using a directory again moves it to the front
+	app.rememberOutputDirectory(dirs[len(dirs)-2])
+
+	config, err := app.loadConfig()
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if len(config.OutputDirectories) != maxOutputDirectories {
+		t.Fatalf("Expected %d directories, got %v", maxOutputDirectories, config.OutputDirectories)
+	}
+	if config.OutputDirectories[0] != dirs[len(dirs)-2] || config.OutputDirectories[1] != dirs[len(dirs)-1] {
+		t.Errorf("Unexpected order: %v", config.OutputDirectories)
+	}
+}
+
+func TestRememberOutputDirectory_WritesOnlyChanges(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	configPath, err := app.getConfigPath()
+	if err != nil {
+		t.Fatalf("getConfigPath failed: %v", err)
+	}
+	if realDir, err := os.UserConfigDir(); err == nil && strings.HasPrefix(configPath, realDir) {
+		t.Fatalf("Expected the tests to use a temporary config, got %s", configPath)
+	}
+
+	dir := filepath.Join(os.TempDir(), "pdf_wizard_output_same")
+	app.rememberOutputDirectory(dir)
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(configPath, old, old); err != nil {
+		t.Fatalf("Failed to age config file: %v", err)
+	}
+
+	// Another job in the most recent directory leaves the file alone
+	app.rememberOutputDirectory(dir)
+	info, err := os.Stat(configPath)
+	if err != nil {
+		t.Fatalf("Failed to stat config file: %v", err)
+	}
+	if !info.ModTime().Equal(old) {
+		t.Errorf("Expected the unchanged config not to be written, modified at %v", info.ModTime())
+	}
+}
+
+func TestStartup_RecoversOutputDirectories(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	stale := filepath.Join(testDir, ".merged-123.pdf.tmp")
+	recent := filepath.Join(testDir, ".merged-456.pdf.tmp")
+	backup := filepath.Join(testDir, ".part1-789.pdf.bak")
+	for _, path := range []string{stale, recent, backup} {
+		if err := os.WriteFile(path, []byte("partial"), 0644); err != nil {
+			t.Fatalf("Failed to write temp file: %v", err)
+		}
+	}
+	old := time.Now().Add(-2 * staleTempFileAge)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatalf("Failed to age temp file: %v", err)
+	}
+
+	// Output directories of submitted jobs are swept on the next startup
+	pdf := filepath.Join(testDir, "test.pdf")
+	if err := createTestPDF(pdf); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	if _, err := app.MergePDFs([]string{pdf}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{}); err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+
+	restarted := NewApp()
+	restarted.startup(context.Background())
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Error("Expected stale temp file to be removed")
+	}
+	if _, err := os.Stat(recent); err != nil {
+		t.Errorf("Expected recent temp file to be kept: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "part1.pdf")); err != nil {
+		t.Errorf("Expected the backup to be restored: %v", err)
+	}
+	if recovered := restarted.GetRecoveredFiles(); len(recovered) != 1 || recovered[0] != filepath.Join(testDir, "part1.pdf") {
+		t.Errorf("Expected part1.pdf to be reported as recovered, got %v", recovered)
+	}
+}
+
+func TestConflictPolicy_DefaultAndSave(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	original, _ := app.GetConflictPolicy()
+	defer app.SetConflictPolicy(original)
+
+	if err := app.SetConflictPolicy(models.ConflictPolicyRename); err != nil {
+		t.Fatalf("SetConflictPolicy failed: %v", err)
+	}
+	policy, err := app.GetConflictPolicy()
+	if err != nil {
+		t.Fatalf("GetConflictPolicy failed: %v", err)
+	}
+	if policy != models.ConflictPolicyRename {
+		t.Errorf("Expected %s, got %s", models.ConflictPolicyRename, policy)
+	}
+
+	for _, invalid := range []string{"", "ask"} {
+		err := app.SetConflictPolicy(invalid)
+		if services.ErrorCodeOf(err) != services.ErrCodeConflictPolicy {
+			t.Errorf("Expected %s for %q, got %v", services.ErrCodeConflictPolicy, invalid, err)
+		}
+	}
+}
+
+func TestMergePDFs_UsesDefaultConflictPolicy(t *testing.T) {
+	app := NewApp()
+	app.startup(context.Background())
+
+	original, _ := app.GetConflictPolicy()
+	defer app.SetConflictPolicy(original)
+	if err := app.SetConflictPolicy(models.ConflictPolicyFail); err != nil {
+		t.Fatalf("SetConflictPolicy failed: %v", err)
+	}
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf := filepath.Join(testDir, "test.pdf")
+	if err := createTestPDF(pdf); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(testDir, "merged.pdf"), []byte("previous"), 0644); err != nil {
+		t.Fatalf("Failed to write existing output: %v", err)
+	}
+
+	// No policy given: the saved default applies
+	_, err := app.MergePDFs([]string{pdf}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
+	if services.ErrorCodeOf(err) != services.ErrCodeOutputExists {
+		t.Errorf("Expected %s, got %v", services.ErrCodeOutputExists, err)
+	}
+
+	// An explicit policy overrides the default
+	result, err := app.MergePDFs([]string{pdf}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{ConflictPolicy: models.ConflictPolicySkip})
+	if err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+	if len(result.Skipped) != 1 {
+		t.Errorf("Expected the output to be skipped, got %+v", result)
+	}
+}
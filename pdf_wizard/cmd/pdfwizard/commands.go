@@ -0,0 +1,856 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"pdf_wizard/models"
+	"pdf_wizard/services"
+)
+
+// stringList is a repeatable string flag (e.g. -range 1-3:part1 -range 4-6:part2)
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// newFlagSet creates a flag set that reports errors instead of exiting
+func newFlagSet(env *cliEnv, name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(env.stderr, "Usage: pdfwizard %s\n\nFlags:\n", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses args, converting flag errors into usage errors
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return &usageError{msg: err.Error()}
+	}
+	return nil
+}
+
+// runMerge merges the positional PDF files into the file given by -o
+func runMerge(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "merge", "merge -o <output.pdf> [-optimize] [-bookmarks filename|title [-nest-outlines]] [-duplex] [-divider] [-spec <inputs.json>] <input.pdf[:pages]>...")
+	output := fs.String("o", "", "output PDF file (required)")
+	specPath := fs.String("spec", "", "JSON file containing an array of MergeInput objects, merged before the arguments")
+	var merge models.MergeOptions
+	fs.BoolVar(&merge.Optimize, "optimize", false, "optimize the merged PDF (see the optimize command)")
+	fs.StringVar(&merge.Bookmarks, "bookmarks", "", "add a bookmark per input titled by its filename or document title: filename or title")
+	fs.BoolVar(&merge.NestOutlines, "nest-outlines", false, "keep each input's bookmarks beneath its -bookmarks entry")
+	fs.BoolVar(&merge.DuplexPadding, "duplex", false, "add a blank page where needed so every input starts on an odd page")
+	fs.BoolVar(&merge.DividerPage, "divider", false, "insert a blank page between inputs")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+
+	var inputs []models.MergeInput
+	if *specPath != "" {
+		if err := readSpec(*specPath, &inputs); err != nil {
+			return commandResult{}, err
+		}
+	}
+	for _, arg := range fs.Args() {
+		inputs = append(inputs, parseMergeInput(arg))
+	}
+	if len(inputs) == 0 {
+		return commandResult{}, newUsageError("at least one input file is required")
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.MergePDFPages(env.ctx, inputs, outputDirectory, outputFilename, merge, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runCollate interleaves the pages of a fronts and a backs scan into the file given by -o
+func runCollate(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "collate", "collate -o <output.pdf> [-reverse-backs] <fronts.pdf> <backs.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	reverseBacks := fs.Bool("reverse-backs", false, "the backs were scanned last page first")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	if fs.NArg() != 2 {
+		return commandResult{}, newUsageError("expected a fronts and a backs file, got %d files", fs.NArg())
+	}
+
+	collate := models.CollateDefinition{FrontsPath: fs.Arg(0), BacksPath: fs.Arg(1), ReverseBacks: *reverseBacks}
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.CollatePDFs(env.ctx, collate, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runSplit splits the input PDF by -range flags, a JSON spec of SplitDefinitions,
+// into chunks of -every pages or -max-size bytes, or at the bookmarks of an
+// -outline level
+func runSplit(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "split", "split -output-dir <dir> (-range <start-end:name>... | -spec <splits.json> | -every <pages> | -burst | -outline <level> | -max-size <size>) [-name <pattern>] <input.pdf>")
+	outputDirectory := fs.String("output-dir", ".", "directory to write the split files to")
+	specPath := fs.String("spec", "", "JSON file containing an array of SplitDefinition objects")
+	every := fs.Int("every", 0, "split into files of this many pages")
+	burst := fs.Bool("burst", false, "split into one file per page (same as -every 1)")
+	outlineLevel := fs.Int("outline", 0, "split at the bookmarks of this outline level, 1 for the top level")
+	maxSize := fs.String("max-size", "", "split into consecutive files of at most this size in bytes, or with a KB, MB or GB suffix (e.g. 10MB, 1000-based)")
+	namePattern := fs.String("name", "", `filename pattern for -every, -burst, -outline and -max-size (default "`+models.DefaultSplitFilenamePattern+`", or "`+models.DefaultOutlineFilenamePattern+`" for -outline); {name}, {n}, {start}, {end} and {title} are replaced, numbers take a width like {n:03}`)
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	var ranges stringList
+	fs.Var(&ranges, "range", "page range and output name, e.g. 1-3:part1 (repeatable)")
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	if *burst {
+		if *every != 0 && *every != 1 {
+			return commandResult{}, newUsageError("-burst cannot be combined with -every %d", *every)
+		}
+		*every = 1
+	}
+	modes := 0
+	for _, set := range []bool{*specPath != "" || len(ranges) > 0, *every != 0, *outlineLevel != 0, *maxSize != ""} {
+		if set {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return commandResult{}, newUsageError("-range or -spec, -every or -burst, -outline and -max-size cannot be combined")
+	}
+
+	var result models.OperationResult
+	switch {
+	case *every != 0:
+		definition := models.SplitEveryDefinition{PagesPerFile: *every, FilenamePattern: *namePattern}
+		result, err = env.pdfService.SplitPDFEvery(env.ctx, inputPath, definition, *outputDirectory, *options)
+	case *outlineLevel != 0:
+		definition := models.SplitOutlineDefinition{Level: *outlineLevel, FilenamePattern: *namePattern}
+		result, err = env.pdfService.SplitPDFByOutline(env.ctx, inputPath, definition, *outputDirectory, *options)
+	case *maxSize != "":
+		maxBytes, parseErr := parseByteSize(*maxSize)
+		if parseErr != nil {
+			return commandResult{}, parseErr
+		}
+		definition := models.SplitSizeDefinition{MaxBytes: maxBytes, FilenamePattern: *namePattern}
+		result, err = env.pdfService.SplitPDFBySize(env.ctx, inputPath, definition, *outputDirectory, *options)
+	default:
+		var splits []models.SplitDefinition
+		if *specPath != "" {
+			if err := readSpec(*specPath, &splits); err != nil {
+				return commandResult{}, err
+			}
+		}
+		for _, value := range ranges {
+			pages, name, ok := strings.Cut(value, ":")
+			if !ok {
+				return commandResult{}, newUsageError("invalid -range %q (expected start-end:name)", value)
+			}
+			start, end, err := parseSpan(pages)
+			if err != nil {
+				return commandResult{}, err
+			}
+			splits = append(splits, models.SplitDefinition{StartPage: start, EndPage: end, Filename: name})
+		}
+		if len(splits) == 0 {
+			return commandResult{}, newUsageError("at least one -range, a -spec file, -every, -burst, -outline or -max-size is required")
+		}
+		result, err = env.pdfService.SplitPDF(env.ctx, inputPath, splits, *outputDirectory, *options)
+	}
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runExtract writes the pages selected by -pages, in that order, to the file given by -o
+func runExtract(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "extract", "extract -o <output.pdf> -pages <range> <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	pageRange := fs.String("pages", "", `pages to extract in order, e.g. "1,4,7-9,last" (required)`)
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	if *pageRange == "" {
+		return commandResult{}, newUsageError("-pages is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.ExtractPages(env.ctx, inputPath, *pageRange, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runDelete writes a copy of the input PDF without the pages selected by -pages
+func runDelete(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "delete", "delete -o <output.pdf> -pages <range> <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	pageRange := fs.String("pages", "", `pages to delete, e.g. "1,3,5-last" (required)`)
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	if *pageRange == "" {
+		return commandResult{}, newUsageError("-pages is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.DeletePages(env.ctx, inputPath, *pageRange, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runReorder writes the input PDF with its pages in the order given by
+// -order, -move, -reverse or a JSON ReorderDefinition
+func runReorder(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "reorder", "reorder -o <output.pdf> (-order <pages> | -move <pages:before|after:page>... | -reverse | -spec <reorder.json>) <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	order := fs.String("order", "", `new order listing every page once, e.g. "3,1,2,4-last"`)
+	reverse := fs.Bool("reverse", false, "reverse the order of all pages")
+	specPath := fs.String("spec", "", "JSON file containing a ReorderDefinition object")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	var moveFlags stringList
+	fs.Var(&moveFlags, "move", "pages to move and where, e.g. 8-9:before:2 (repeatable, applied in order)")
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	var reorder models.ReorderDefinition
+	if *specPath != "" {
+		if err := readSpec(*specPath, &reorder); err != nil {
+			return commandResult{}, err
+		}
+	}
+	if *order != "" {
+		reorder.Sequence = *order
+	}
+	if *reverse {
+		reorder.Reverse = true
+	}
+	for _, value := range moveFlags {
+		parts := strings.Split(value, ":")
+		if len(parts) != 3 {
+			return commandResult{}, newUsageError("invalid -move %q (expected pages:before|after:page)", value)
+		}
+		target, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return commandResult{}, newUsageError("invalid target page in %q", value)
+		}
+		reorder.Moves = append(reorder.Moves, models.PageMove{Pages: parts[0], Position: strings.TrimSpace(parts[1]), Target: target})
+	}
+	if reorder.Sequence == "" && len(reorder.Moves) == 0 && !reorder.Reverse {
+		return commandResult{}, newUsageError("one of -order, -move, -reverse or -spec is required")
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.ReorderPages(env.ctx, inputPath, reorder, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runInsert inserts blank pages or pages of another PDF before or after a page
+func runInsert(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "insert", "insert -o <output.pdf> (-before <page> | -after <page>) (-blank <count> | -from <other.pdf> [-from-pages <pages>]) <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	before := fs.Int("before", 0, "insert before this page")
+	after := fs.Int("after", 0, "insert after this page")
+	blank := fs.Int("blank", 0, "number of blank pages, sized like the neighbouring page")
+	from := fs.String("from", "", "PDF file to insert pages from")
+	fromPages := fs.String("from-pages", "", `pages of -from to insert, e.g. "1,3-last" (default all)`)
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	insert := models.InsertDefinition{BlankPages: *blank, SourcePath: *from, SourcePages: *fromPages}
+	switch {
+	case *before != 0 && *after == 0:
+		insert.Page, insert.Position = *before, models.PositionBefore
+	case *after != 0 && *before == 0:
+		insert.Page, insert.Position = *after, models.PositionAfter
+	default:
+		return commandResult{}, newUsageError("exactly one of -before or -after is required")
+	}
+	if *blank == 0 && *from == "" {
+		return commandResult{}, newUsageError("one of -blank or -from is required")
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.InsertPages(env.ctx, inputPath, insert, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runRotate rotates page ranges given by -rotate flags or a JSON spec of RotateDefinitions
+func runRotate(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "rotate", "rotate -o <output.pdf> (-rotate <start-end:angle>... | -spec <rotations.json>) <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	specPath := fs.String("spec", "", "JSON file containing an array of RotateDefinition objects")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	var rotateFlags stringList
+	fs.Var(&rotateFlags, "rotate", "page range and angle (90, -90 or 180), e.g. 1-3:90 (repeatable)")
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	var rotations []models.RotateDefinition
+	if *specPath != "" {
+		if err := readSpec(*specPath, &rotations); err != nil {
+			return commandResult{}, err
+		}
+	}
+	for _, value := range rotateFlags {
+		pages, angle, ok := strings.Cut(value, ":")
+		if !ok {
+			return commandResult{}, newUsageError("invalid -rotate %q (expected start-end:angle)", value)
+		}
+		start, end, err := parseSpan(pages)
+		if err != nil {
+			return commandResult{}, err
+		}
+		rotation, err := strconv.Atoi(strings.TrimSpace(angle))
+		if err != nil {
+			return commandResult{}, newUsageError("invalid rotation angle in %q", value)
+		}
+		rotations = append(rotations, models.RotateDefinition{StartPage: start, EndPage: end, Rotation: rotation})
+	}
+	if len(rotations) == 0 {
+		return commandResult{}, newUsageError("at least one -rotate or a -spec file is required")
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.RotatePDF(env.ctx, inputPath, rotations, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runWatermark applies a text watermark configured by flags and/or a JSON WatermarkDefinition
+func runWatermark(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "watermark", "watermark -o <output.pdf> (-text <text> | -spec <watermark.json>) [flags] <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	specPath := fs.String("spec", "", "JSON file containing a WatermarkDefinition object")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	text := fs.String("text", "", "watermark text")
+	fontSize := fs.Int("font-size", 48, "font size in points")
+	fontColor := fs.String("color", "#808080", "font color as a hex code")
+	opacity := fs.Float64("opacity", 0.5, "opacity between 0.0 and 1.0")
+	rotation := fs.Int("rotation", 45, "rotation in degrees")
+	position := fs.String("position", "center", "position (center, top-left, top-right, bottom-left, bottom-right, ...)")
+	fontFamily := fs.String("font", "Helvetica", "font family")
+	pageRange := fs.String("pages", "all", `pages to watermark: "all" or a range like "1,3,5-10,last"`)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	watermark := models.WatermarkDefinition{
+		TextConfig: models.TextWatermarkConfig{
+			Text:       *text,
+			FontSize:   *fontSize,
+			FontColor:  *fontColor,
+			Opacity:    *opacity,
+			Rotation:   *rotation,
+			Position:   *position,
+			FontFamily: *fontFamily,
+		},
+		PageRange: *pageRange,
+	}
+	if *specPath != "" {
+		if err := readSpec(*specPath, &watermark); err != nil {
+			return commandResult{}, err
+		}
+		// Flags given explicitly on the command line override the spec file
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "text":
+				watermark.TextConfig.Text = *text
+			case "font-size":
+				watermark.TextConfig.FontSize = *fontSize
+			case "color":
+				watermark.TextConfig.FontColor = *fontColor
+			case "opacity":
+				watermark.TextConfig.Opacity = *opacity
+			case "rotation":
+				watermark.TextConfig.Rotation = *rotation
+			case "position":
+				watermark.TextConfig.Position = *position
+			case "font":
+				watermark.TextConfig.FontFamily = *fontFamily
+			case "pages":
+				watermark.PageRange = *pageRange
+			}
+		})
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.ApplyWatermark(env.ctx, inputPath, watermark, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runEncrypt password-protects the input PDF configured by flags and/or a JSON EncryptionDefinition
+func runEncrypt(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "encrypt", "encrypt -o <output.pdf> (-owner-password <pw> | -spec <encryption.json>) [flags] <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	specPath := fs.String("spec", "", "JSON file containing an EncryptionDefinition object (keeps passwords out of the shell history)")
+	options := outputOptionsFlag(fs)
+	userPassword := fs.String("user-password", "", "password needed to open the PDF (empty: opens without a password)")
+	ownerPassword := fs.String("owner-password", "", "password needed to change passwords and permissions")
+	algorithm := fs.String("algorithm", models.EncryptionAES256, "encryption algorithm: aes128 or aes256")
+	allow := fs.String("allow", "", `comma-separated permissions granted to users: print, copy, modify, annotate`)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	encryption := models.EncryptionDefinition{
+		UserPassword:  *userPassword,
+		OwnerPassword: *ownerPassword,
+		Algorithm:     *algorithm,
+	}
+	if *specPath != "" {
+		if err := readSpec(*specPath, &encryption); err != nil {
+			return commandResult{}, err
+		}
+	}
+	// Flags given explicitly on the command line override the spec file
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "user-password":
+			encryption.UserPassword = *userPassword
+		case "owner-password":
+			encryption.OwnerPassword = *ownerPassword
+		case "algorithm":
+			encryption.Algorithm = *algorithm
+		case "allow":
+			encryption.Permissions, flagErr = parsePermissions(*allow)
+		}
+	})
+	if flagErr != nil {
+		return commandResult{}, flagErr
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.EncryptPDF(env.ctx, inputPath, encryption, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runOptimize writes a smaller copy of the input PDF
+func runOptimize(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "optimize", "optimize -o <output.pdf> <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.OptimizePDF(env.ctx, inputPath, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runDecrypt writes an unencrypted copy of a password-protected input PDF
+func runDecrypt(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "decrypt", "decrypt -o <output.pdf> -password <input.pdf=password> <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	options := outputOptionsFlag(fs)
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.DecryptPDF(env.ctx, inputPath, "", outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runPermissions changes the permissions and/or passwords of an encrypted input
+// PDF configured by flags and/or a JSON PermissionChange
+func runPermissions(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "permissions", "permissions -o <output.pdf> (-owner-password <pw> | -spec <change.json>) [flags] <input.pdf>")
+	output := fs.String("o", "", "output PDF file (required)")
+	specPath := fs.String("spec", "", "JSON file containing a PermissionChange object (keeps passwords out of the shell history)")
+	options := outputOptionsFlag(fs)
+	userPassword := fs.String("user-password", "", "current user password (empty if the PDF opens without one)")
+	ownerPassword := fs.String("owner-password", "", "current owner password")
+	allow := fs.String("allow", "", `new comma-separated permissions: print, copy, modify, annotate (omit to keep the current ones)`)
+	newUserPassword := fs.String("new-user-password", "", "new user password (omit to keep it, empty to remove it)")
+	newOwnerPassword := fs.String("new-owner-password", "", "new owner password (omit to keep it)")
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if *output == "" {
+		return commandResult{}, newUsageError("-o is required")
+	}
+	inputPath, err := singleInput(fs)
+	if err != nil {
+		return commandResult{}, err
+	}
+
+	change := models.PermissionChange{
+		UserPassword:  *userPassword,
+		OwnerPassword: *ownerPassword,
+	}
+	if *specPath != "" {
+		if err := readSpec(*specPath, &change); err != nil {
+			return commandResult{}, err
+		}
+	}
+	// Flags given explicitly on the command line override the spec file
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "user-password":
+			change.UserPassword = *userPassword
+		case "owner-password":
+			change.OwnerPassword = *ownerPassword
+		case "allow":
+			var permissions models.PDFPermissions
+			permissions, flagErr = parsePermissions(*allow)
+			change.Permissions = &permissions
+		case "new-user-password":
+			change.NewUserPassword = newUserPassword
+		case "new-owner-password":
+			change.NewOwnerPassword = newOwnerPassword
+		}
+	})
+	if flagErr != nil {
+		return commandResult{}, flagErr
+	}
+
+	outputDirectory, outputFilename := splitOutputPath(*output)
+	result, err := env.pdfService.ChangePermissions(env.ctx, inputPath, change, outputDirectory, outputFilename, *options)
+	if err != nil {
+		return commandResult{}, err
+	}
+	return operationResult(result), nil
+}
+
+// runInfo prints PDF metadata for every positional file
+func runInfo(env *cliEnv, args []string) (commandResult, error) {
+	fs := newFlagSet(env, "info", "info [-password <file=password>]... <input.pdf>...")
+	passwords := passwordFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return commandResult{}, err
+	}
+	if err := setPasswords(env, *passwords); err != nil {
+		return commandResult{}, err
+	}
+	if fs.NArg() == 0 {
+		return commandResult{}, newUsageError("at least one input file is required")
+	}
+
+	files := make([]models.PDFMetadata, 0, fs.NArg())
+	for _, path := range fs.Args() {
+		metadata, err := env.fileService.GetPDFMetadata(path)
+		if err != nil {
+			return commandResult{}, fmt.Errorf("%s: %w", path, err)
+		}
+		files = append(files, metadata)
+	}
+	return commandResult{Files: files}, nil
+}
+
+// outputOptionsFlag registers the -on-conflict and -decrypt flags of commands that write PDFs
+func outputOptionsFlag(fs *flag.FlagSet) *models.OutputOptions {
+	options := &models.OutputOptions{}
+	fs.StringVar(&options.ConflictPolicy, "on-conflict", models.ConflictPolicyOverwrite, "what to do if an output file exists: overwrite, rename, skip or fail")
+	fs.BoolVar(&options.Decrypt, "decrypt", false, "write password-protected inputs without their protection")
+	return options
+}
+
+// passwordFlag registers the repeatable -password flag for password-protected inputs
+func passwordFlag(fs *flag.FlagSet) *stringList {
+	var passwords stringList
+	fs.Var(&passwords, "password", "password of a protected input, e.g. in.pdf=secret (repeatable)")
+	return &passwords
+}
+
+// setPasswords stores the -password values given as file=password
+func setPasswords(env *cliEnv, values stringList) error {
+	for _, value := range values {
+		path, password, ok := strings.Cut(value, "=")
+		if !ok || path == "" {
+			return newUsageError("invalid -password %q (expected file=password)", value)
+		}
+		if err := env.fileService.SetPDFPassword(path, password); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// singleInput returns the only positional argument of a command
+func singleInput(fs *flag.FlagSet) (string, error) {
+	if fs.NArg() != 1 {
+		return "", newUsageError("exactly one input file is required, got %d", fs.NArg())
+	}
+	return fs.Arg(0), nil
+}
+
+// parseMergeInput parses a merge argument like "report.pdf" or
+// "report.pdf:1-2,last". Arguments ending in .pdf are paths as a whole, so
+// paths containing colons (e.g. C:\report.pdf) need no page range.
+func parseMergeInput(arg string) models.MergeInput {
+	i := strings.LastIndex(arg, ":")
+	if i < 0 || strings.EqualFold(filepath.Ext(arg), services.PDFExtension) {
+		return models.MergeInput{Path: arg}
+	}
+	return models.MergeInput{Path: arg[:i], Pages: arg[i+1:]}
+}
+
+// readSpec decodes a JSON spec file into v
+func readSpec(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return newUsageError("failed to read spec file: %v", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return newUsageError("invalid spec file %s: %v", path, err)
+	}
+	return nil
+}
+
+// parsePermissions parses a comma-separated permission list like "print,copy"
+func parsePermissions(value string) (models.PDFPermissions, error) {
+	var permissions models.PDFPermissions
+	for _, name := range strings.Split(value, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "print":
+			permissions.Print = true
+		case "copy":
+			permissions.Copy = true
+		case "modify":
+			permissions.Modify = true
+		case "annotate":
+			permissions.Annotate = true
+		default:
+			return permissions, newUsageError("unknown permission %q (must be print, copy, modify or annotate)", name)
+		}
+	}
+	return permissions, nil
+}
+
+// parseSpan parses "3" or "1-5" into an inclusive start/end page pair
+func parseSpan(value string) (int, int, error) {
+	startStr, endStr, isRange := strings.Cut(strings.TrimSpace(value), "-")
+	start, err := strconv.Atoi(strings.TrimSpace(startStr))
+	if err != nil {
+		return 0, 0, newUsageError("invalid page range %q", value)
+	}
+	if !isRange {
+		return start, start, nil
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(endStr))
+	if err != nil {
+		return 0, 0, newUsageError("invalid page range %q", value)
+	}
+	return start, end, nil
+}
+
+// parseByteSize parses a size like "2500000", "500KB" or "10MB" into bytes.
+// Units are 1000-based, which stays below limits meant as either 1000 or 1024.
+func parseByteSize(value string) (int64, error) {
+	number := strings.ToUpper(strings.TrimSpace(value))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int64
+	}{{"GB", 1000 * 1000 * 1000}, {"MB", 1000 * 1000}, {"KB", 1000}, {"B", 1}} {
+		if strings.HasSuffix(number, unit.suffix) {
+			number, multiplier = strings.TrimSpace(strings.TrimSuffix(number, unit.suffix)), unit.multiplier
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(number, 64)
+	bytes := n * float64(multiplier)
+	// The comparisons also reject NaN
+	if err != nil || !(bytes >= 1 && bytes < math.MaxInt64) {
+		return 0, newUsageError("invalid size %q (expected e.g. 2500000, 500KB or 10MB)", value)
+	}
+	return int64(bytes), nil
+}
+
+// splitOutputPath splits an output file path into the directory and the
+// filename without .pdf extension, as expected by PDFService
+func splitOutputPath(path string) (string, string) {
+	dir, file := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	if strings.EqualFold(filepath.Ext(file), services.PDFExtension) {
+		file = file[:len(file)-len(services.PDFExtension)]
+	}
+	return filepath.Clean(dir), file
+}
+
+// operationResult converts a service result into the command's JSON output
+func operationResult(result models.OperationResult) commandResult {
+	outputs := make([]string, 0, len(result.Outputs))
+	for _, output := range result.Outputs {
+		outputs = append(outputs, output.Path)
+	}
+	return commandResult{Outputs: outputs, Result: &result}
+}
@@ -0,0 +1,170 @@
+// Command pdfwizard is a headless command-line front end for the PDF Wizard
+// services. It runs the same merge, split, rotate, watermark and encrypt
+// operations as the desktop app and prints machine-readable JSON results.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"pdf_wizard/models"
+	"pdf_wizard/services"
+)
+
+// Exit codes returned by the CLI
+const (
+	exitOK        = 0   // Operation succeeded
+	exitFailure   = 1   // Operation failed (invalid input file, pdfcpu error, ...)
+	exitUsage     = 2   // Invalid command line (unknown command, bad flags)
+	exitCancelled = 130 // Operation interrupted (Ctrl+C)
+	usageOverview = `Usage: pdfwizard <command> [flags] [files...]
+
+Commands:
+  merge        Merge PDF files in order into a single PDF
+  collate      Interleave the pages of front and back scans into a single PDF
+  split        Split a PDF by page ranges, page count, bookmarks or file size
+  extract      Copy selected pages, in the given order, into a new PDF
+  delete       Remove selected pages from a PDF
+  reorder      Rearrange, move or reverse the pages of a PDF
+  insert       Insert blank pages or pages of another PDF
+  rotate       Rotate page ranges in a PDF
+  watermark    Apply a text watermark to a PDF
+  encrypt      Password-protect a PDF and restrict its permissions
+  optimize     Shrink a PDF by deduplicating resources and compressing streams
+  decrypt      Write an unencrypted copy of a password-protected PDF
+  permissions  Change the permissions or passwords of an encrypted PDF
+  info         Print metadata (including page count) for PDF files
+
+Run "pdfwizard <command> -h" for the flags of a command.
+Results are printed to stdout as JSON.
+Exit codes: 0 success, 1 failure, 2 usage error, 130 interrupted.
+`
+)
+
+// commandResult is the JSON document printed to stdout after a command runs
+type commandResult struct {
+	OK        bool                    `json:"ok"`
+	Command   string                  `json:"command"`
+	Outputs   []string                `json:"outputs,omitempty"`
+	Result    *models.OperationResult `json:"result,omitempty"` // Sizes, page counts, hashes and warnings of the outputs
+	Files     interface{}             `json:"files,omitempty"`
+	Error     string                  `json:"error,omitempty"`
+	ErrorInfo *models.ErrorInfo       `json:"errorInfo,omitempty"` // Error code and params of a failed operation
+}
+
+// usageError marks errors caused by an invalid command line
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// newUsageError creates a usage error with a formatted message
+func newUsageError(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// command is a CLI subcommand
+type command func(env *cliEnv, args []string) (commandResult, error)
+
+// cliEnv holds the services and output streams shared by all commands
+type cliEnv struct {
+	ctx         context.Context
+	fileService *services.FileService
+	pdfService  *services.PDFService
+	stderr      io.Writer
+}
+
+var commands = map[string]command{
+	"merge":       runMerge,
+	"collate":     runCollate,
+	"split":       runSplit,
+	"extract":     runExtract,
+	"delete":      runDelete,
+	"reorder":     runReorder,
+	"insert":      runInsert,
+	"rotate":      runRotate,
+	"watermark":   runWatermark,
+	"encrypt":     runEncrypt,
+	"optimize":    runOptimize,
+	"decrypt":     runDecrypt,
+	"permissions": runPermissions,
+	"info":        runInfo,
+}
+
+func main() {
+	// Ctrl+C cancels the running operation so partial outputs are cleaned up
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the CLI with the given arguments and returns the process exit code
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usageOverview)
+		return exitUsage
+	}
+
+	name := args[0]
+	if name == "help" || name == "-h" || name == "--help" {
+		fmt.Fprint(stdout, usageOverview)
+		return exitOK
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command: %s\n\n%s", name, usageOverview)
+		return exitUsage
+	}
+
+	// Services run without a Wails runtime, so no dialogs are available
+	fileService := services.NewFileService(nil)
+	env := &cliEnv{
+		ctx:         ctx,
+		fileService: fileService,
+		pdfService:  services.NewPDFService(fileService, nil),
+		stderr:      stderr,
+	}
+
+	result, err := cmd(env, args[1:])
+	result.Command = name
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		var uerr *usageError
+		if errors.As(err, &uerr) {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			return exitUsage
+		}
+		result.OK = false
+		result.Error = err.Error()
+		result.ErrorInfo = services.ToErrorInfo(err)
+		writeJSON(stdout, result)
+		if errors.Is(err, context.Canceled) {
+			return exitCancelled
+		}
+		return exitFailure
+	}
+
+	result.OK = true
+	writeJSON(stdout, result)
+	return exitOK
+}
+
+// writeJSON writes v to w as indented JSON followed by a newline
+func writeJSON(w io.Writer, v interface{}) {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(v)
+}
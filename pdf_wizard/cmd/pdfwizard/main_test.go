@@ -0,0 +1,811 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+)
+
+// createTestPDF creates a minimal valid PDF file for testing
+func createTestPDF(path string) error {
+	pdfContent := `%PDF-1.4
+1 0 obj
+<<
+/Type /Catalog
+/Pages 2 0 R
+>>
+endobj
+2 0 obj
+<<
+/Type /Pages
+/Kids [3 0 R]
+/Count 1
+>>
+endobj
+3 0 obj
+<<
+/Type /Page
+/Parent 2 0 R
+/MediaBox [0 0 612 792]
+/Resources <<
+/Font <<
+/F1 4 0 R
+>>
+>>
+/Contents 5 0 R
+>>
+endobj
+4 0 obj
+<<
+/Type /Font
+/Subtype /Type1
+/BaseFont /Helvetica
+>>
+endobj
+5 0 obj
+<<
+/Length 44
+>>
+stream
+BT
+/F1 12 Tf
+100 700 Td
+(Test PDF) Tj
+ET
+endstream
+endobj
+xref
+0 6
+0000000000 65535 f
+0000000009 00000 n
+0000000058 00000 n
+0000000115 00000 n
+0000000299 00000 n
+0000000417 00000 n
+trailer
+<<
+/Size 6
+/Root 1 0 R
+>>
+startxref
+520
+%%EOF`
+
+	return os.WriteFile(path, []byte(pdfContent), 0644)
+}
+
+// createMultiPageTestPDF creates a PDF with the given number of pages
+func createMultiPageTestPDF(path string, numPages int) error {
+	dir := filepath.Dir(path)
+	var pages []string
+	for i := 0; i < numPages; i++ {
+		page := filepath.Join(dir, fmt.Sprintf("page_%d.pdf", i))
+		if err := createTestPDF(page); err != nil {
+			return err
+		}
+		pages = append(pages, page)
+	}
+	defer func() {
+		for _, page := range pages {
+			os.Remove(page)
+		}
+	}()
+	return api.MergeCreateFile(pages, path, false, model.NewDefaultConfiguration())
+}
+
+// runCLI runs the CLI and decodes its JSON output
+func runCLI(t *testing.T, args ...string) (int, commandResult, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+
+	var result commandResult
+	if stdout.Len() > 0 {
+		if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+			t.Fatalf("Output is not valid JSON: %v\n%s", err, stdout.String())
+		}
+	}
+	return code, result, stderr.String()
+}
+
+func TestRun_NoArguments(t *testing.T) {
+	code, _, stderr := runCLI(t)
+	if code != exitUsage {
+		t.Errorf("Expected exit code %d, got %d", exitUsage, code)
+	}
+	if stderr == "" {
+		t.Error("Expected usage on stderr")
+	}
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	code, _, _ := runCLI(t, "shred", "file.pdf")
+	if code != exitUsage {
+		t.Errorf("Expected exit code %d, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_Merge(t *testing.T) {
+	testDir := t.TempDir()
+	pdf1 := filepath.Join(testDir, "a.pdf")
+	pdf2 := filepath.Join(testDir, "b.pdf")
+	for _, path := range []string{pdf1, pdf2} {
+		if err := createTestPDF(path); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+	}
+
+	output := filepath.Join(testDir, "merged.pdf")
+	code, result, stderr := runCLI(t, "merge", "-o", output, pdf1, pdf2)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if !result.OK || result.Command != "merge" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if len(result.Outputs) != 1 || result.Outputs[0] != output {
+		t.Errorf("Expected outputs [%s], got %v", output, result.Outputs)
+	}
+	if result.Result == nil || len(result.Result.Outputs) != 1 || result.Result.Outputs[0].PageCount != 2 || result.Result.Outputs[0].SHA256 == "" {
+		t.Errorf("Expected operation result for the merged file, got %+v", result.Result)
+	}
+
+	pageCount, err := api.PageCountFile(output)
+	if err != nil {
+		t.Fatalf("Failed to read merged file: %v", err)
+	}
+	if pageCount != 2 {
+		t.Errorf("Expected 2 pages, got %d", pageCount)
+	}
+}
+
+func TestRun_Merge_PageRanges(t *testing.T) {
+	testDir := t.TempDir()
+	pdf1 := filepath.Join(testDir, "a.pdf")
+	pdf2 := filepath.Join(testDir, "b.pdf")
+	for _, path := range []string{pdf1, pdf2} {
+		if err := createMultiPageTestPDF(path, 4); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+	}
+
+	output := filepath.Join(testDir, "merged.pdf")
+	code, result, stderr := runCLI(t, "merge", "-o", output, pdf1+":1-2", pdf2, pdf1+":last")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if n, err := api.PageCountFile(output); err != nil || n != 7 {
+		t.Errorf("Expected 7 pages, got %d (%v)", n, err)
+	}
+
+	code, result, _ = runCLI(t, "merge", "-o", output, pdf1, pdf2+":5")
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "MERGE_PAGE_RANGE_INVALID" {
+		t.Errorf("Expected exit code %d with MERGE_PAGE_RANGE_INVALID, got %d (%+v)", exitFailure, code, result.ErrorInfo)
+	}
+}
+
+func TestRun_Merge_Bookmarks(t *testing.T) {
+	testDir := t.TempDir()
+	pdf1 := filepath.Join(testDir, "a.pdf")
+	pdf2 := filepath.Join(testDir, "b.pdf")
+	for _, path := range []string{pdf1, pdf2} {
+		if err := createMultiPageTestPDF(path, 2); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+	}
+
+	output := filepath.Join(testDir, "merged.pdf")
+	code, result, stderr := runCLI(t, "merge", "-o", output, "-bookmarks", "filename", pdf1, pdf2)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	f, err := os.Open(output)
+	if err != nil {
+		t.Fatalf("Failed to open merged file: %v", err)
+	}
+	defer f.Close()
+	bookmarks, err := api.Bookmarks(f, nil)
+	if err != nil || len(bookmarks) != 2 || bookmarks[0].Title != "a" || bookmarks[1].Title != "b" {
+		t.Errorf("Expected bookmarks a and b, got %+v (%v)", bookmarks, err)
+	}
+
+	code, result, _ = runCLI(t, "merge", "-o", output, "-bookmarks", "chapters", pdf1)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "INVALID_BOOKMARKS" {
+		t.Errorf("Expected exit code %d with INVALID_BOOKMARKS, got %d (%+v)", exitFailure, code, result.ErrorInfo)
+	}
+}
+
+func TestRun_Merge_Duplex(t *testing.T) {
+	testDir := t.TempDir()
+	pdf1 := filepath.Join(testDir, "a.pdf")
+	pdf2 := filepath.Join(testDir, "b.pdf")
+	if err := createMultiPageTestPDF(pdf1, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	if err := createTestPDF(pdf2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// With a divider, the duplex padding goes before it so b still starts on page 5
+	output := filepath.Join(testDir, "merged.pdf")
+	for _, tt := range []struct {
+		flags []string
+		pages int
+	}{
+		{[]string{"-duplex"}, 3},
+		{[]string{"-divider"}, 4},
+		{[]string{"-duplex", "-divider"}, 5},
+	} {
+		args := append(append([]string{"merge", "-o", output}, tt.flags...), pdf1, pdf2)
+		code, result, stderr := runCLI(t, args...)
+		if code != exitOK {
+			t.Fatalf("%v: expected exit code %d, got %d (error: %s %s)", tt.flags, exitOK, code, result.Error, stderr)
+		}
+		if n, err := api.PageCountFile(output); err != nil || n != tt.pages {
+			t.Errorf("%v: expected %d pages, got %d (%v)", tt.flags, tt.pages, n, err)
+		}
+	}
+}
+
+func TestRun_Merge_MissingOutput(t *testing.T) {
+	code, _, _ := runCLI(t, "merge", "a.pdf")
+	if code != exitUsage {
+		t.Errorf("Expected exit code %d, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_Merge_NonExistentInput(t *testing.T) {
+	testDir := t.TempDir()
+	code, result, _ := runCLI(t, "merge", "-o", filepath.Join(testDir, "out.pdf"), filepath.Join(testDir, "missing.pdf"))
+	if code != exitFailure {
+		t.Errorf("Expected exit code %d, got %d", exitFailure, code)
+	}
+	if result.OK || result.Error == "" {
+		t.Errorf("Expected error result, got %+v", result)
+	}
+}
+
+func TestRun_Collate(t *testing.T) {
+	testDir := t.TempDir()
+	fronts := filepath.Join(testDir, "fronts.pdf")
+	backs := filepath.Join(testDir, "backs.pdf")
+	if err := createMultiPageTestPDF(fronts, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	if err := createMultiPageTestPDF(backs, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "collated.pdf")
+	code, result, stderr := runCLI(t, "collate", "-o", output, "-reverse-backs", fronts, backs)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if n, err := api.PageCountFile(output); err != nil || n != 5 {
+		t.Errorf("Expected 5 pages, got %d (%v)", n, err)
+	}
+
+	// The backs may not outnumber the fronts
+	code, result, _ = runCLI(t, "collate", "-o", output, backs, fronts)
+	if code != exitFailure {
+		t.Fatalf("Expected exit code %d, got %d", exitFailure, code)
+	}
+	if result.ErrorInfo == nil || result.ErrorInfo.Code != "COLLATE_PAGE_COUNT_MISMATCH" {
+		t.Errorf("Expected COLLATE_PAGE_COUNT_MISMATCH, got %+v", result.ErrorInfo)
+	}
+
+	if code, _, _ := runCLI(t, "collate", "-o", output, fronts); code != exitUsage {
+		t.Errorf("Expected exit code %d for a single input, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_Split(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 5); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	code, result, stderr := runCLI(t, "split", "-output-dir", testDir, "-range", "1-2:first", "-range", "3-5:second", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+
+	expected := map[string]int{"first.pdf": 2, "second.pdf": 3}
+	for name, pages := range expected {
+		pageCount, err := api.PageCountFile(filepath.Join(testDir, name))
+		if err != nil {
+			t.Errorf("Split file %s was not created: %v", name, err)
+			continue
+		}
+		if pageCount != pages {
+			t.Errorf("Expected %d pages in %s, got %d", pages, name, pageCount)
+		}
+	}
+}
+
+func TestRun_Split_Every(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 5); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	code, result, stderr := runCLI(t, "split", "-output-dir", testDir, "-every", "2", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	expected := map[string]int{"input_001.pdf": 2, "input_002.pdf": 2, "input_003.pdf": 1}
+	for name, pages := range expected {
+		pageCount, err := api.PageCountFile(filepath.Join(testDir, name))
+		if err != nil {
+			t.Errorf("Split file %s was not created: %v", name, err)
+			continue
+		}
+		if pageCount != pages {
+			t.Errorf("Expected %d pages in %s, got %d", pages, name, pageCount)
+		}
+	}
+
+	code, result, stderr = runCLI(t, "split", "-output-dir", testDir, "-burst", "-name", "page{n:02}", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if len(result.Outputs) != 5 || filepath.Base(result.Outputs[4]) != "page05.pdf" {
+		t.Errorf("Expected 5 outputs ending with page05.pdf, got %v", result.Outputs)
+	}
+
+	code, result, _ = runCLI(t, "split", "-output-dir", testDir, "-burst", "-name", "{title}", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "INVALID_FILENAME_PATTERN" {
+		t.Errorf("Expected INVALID_FILENAME_PATTERN, got exit code %d and %+v", code, result.ErrorInfo)
+	}
+
+	if code, _, _ := runCLI(t, "split", "-output-dir", testDir, "-every", "2", "-range", "1-2:first", input); code != exitUsage {
+		t.Errorf("Expected exit code %d for -every with -range, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_Split_Outline(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 4); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	bookmarks := []pdfcpu.Bookmark{{Title: "Intro", PageFrom: 1}, {Title: "Results", PageFrom: 2}}
+	if err := api.AddBookmarksFile(input, "", bookmarks, true, nil); err != nil {
+		t.Fatalf("Failed to add bookmarks: %v", err)
+	}
+
+	code, result, stderr := runCLI(t, "split", "-output-dir", testDir, "-outline", "1", "-name", "{n} {title}", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	expected := map[string]int{"1 Intro.pdf": 1, "2 Results.pdf": 3}
+	for name, pages := range expected {
+		pageCount, err := api.PageCountFile(filepath.Join(testDir, name))
+		if err != nil {
+			t.Errorf("Split file %s was not created: %v", name, err)
+			continue
+		}
+		if pageCount != pages {
+			t.Errorf("Expected %d pages in %s, got %d", pages, name, pageCount)
+		}
+	}
+
+	code, result, _ = runCLI(t, "split", "-output-dir", testDir, "-outline", "2", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "NO_BOOKMARKS_AT_LEVEL" {
+		t.Errorf("Expected NO_BOOKMARKS_AT_LEVEL, got exit code %d and %+v", code, result.ErrorInfo)
+	}
+
+	if code, _, _ := runCLI(t, "split", "-output-dir", testDir, "-outline", "1", "-every", "2", input); code != exitUsage {
+		t.Errorf("Expected exit code %d for -outline with -every, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_Split_MaxSize(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	code, result, stderr := runCLI(t, "split", "-output-dir", testDir, "-max-size", "1MB", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if len(result.Outputs) != 1 || filepath.Base(result.Outputs[0]) != "input_001.pdf" {
+		t.Errorf("Expected a single input_001.pdf, got %v", result.Outputs)
+	}
+
+	code, result, _ = runCLI(t, "split", "-output-dir", testDir, "-max-size", "100", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "SPLIT_PAGE_TOO_LARGE" {
+		t.Errorf("Expected SPLIT_PAGE_TOO_LARGE, got exit code %d and %+v", code, result.ErrorInfo)
+	}
+
+	for _, size := range []string{"0", "-5MB", "ten", "NaN"} {
+		if code, _, _ := runCLI(t, "split", "-output-dir", testDir, "-max-size", size, input); code != exitUsage {
+			t.Errorf("Expected exit code %d for -max-size %s, got %d", exitUsage, size, code)
+		}
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := map[string]int64{
+		"2500000": 2500000,
+		"500KB":   500000,
+		"10 MB":   10000000,
+		"1.5gb":   1500000000,
+		"12B":     12,
+	}
+	for value, want := range tests {
+		if got, err := parseByteSize(value); err != nil || got != want {
+			t.Errorf("parseByteSize(%q) = %d, %v; expected %d", value, got, err, want)
+		}
+	}
+}
+
+func TestRun_Split_SpecFile(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 4); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	spec := filepath.Join(testDir, "splits.json")
+	specContent := `[{"startPage": 1, "endPage": 1, "filename": "cover"}, {"startPage": 2, "endPage": 4, "filename": "body"}]`
+	if err := os.WriteFile(spec, []byte(specContent), 0644); err != nil {
+		t.Fatalf("Failed to write spec: %v", err)
+	}
+
+	code, result, stderr := runCLI(t, "split", "-output-dir", testDir, "-spec", spec, input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if len(result.Outputs) != 2 {
+		t.Errorf("Expected 2 outputs, got %v", result.Outputs)
+	}
+}
+
+func TestRun_Rotate_InvalidAngle(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createTestPDF(input); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	code, result, _ := runCLI(t, "rotate", "-o", filepath.Join(testDir, "rotated.pdf"), "-rotate", "1-1:45", input)
+	if code != exitFailure {
+		t.Errorf("Expected exit code %d, got %d", exitFailure, code)
+	}
+	if result.Error == "" {
+		t.Error("Expected error message in result")
+	}
+	if result.ErrorInfo == nil || result.ErrorInfo.Code != "INVALID_ROTATION_ANGLE" || result.ErrorInfo.Params["angle"] != float64(45) {
+		t.Errorf("Expected INVALID_ROTATION_ANGLE error info, got %+v", result.ErrorInfo)
+	}
+}
+
+func TestRun_Watermark(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "watermarked.pdf")
+	code, result, stderr := runCLI(t, "watermark", "-o", output, "-text", "DRAFT", "-pages", "1,3", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if _, err := os.Stat(output); err != nil {
+		t.Errorf("Watermarked file was not created: %v", err)
+	}
+}
+
+func TestRun_Info(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), []string{"info", input}, &stdout, &stderr); code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (%s)", exitOK, code, stderr.String())
+	}
+
+	var result struct {
+		Files []struct {
+			Path       string `json:"path"`
+			TotalPages int    `json:"totalPages"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if len(result.Files) != 1 || result.Files[0].TotalPages != 3 {
+		t.Errorf("Unexpected info output: %s", stdout.String())
+	}
+}
+
+func TestSplitOutputPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		wantDir  string
+		wantName string
+	}{
+		{"out.pdf", ".", "out"},
+		{"dir/report.PDF", "dir", "report"},
+		{"dir/report", "dir", "report"},
+	}
+	for _, tt := range tests {
+		dir, name := splitOutputPath(tt.path)
+		if dir != tt.wantDir || name != tt.wantName {
+			t.Errorf("splitOutputPath(%q) = (%q, %q), want (%q, %q)", tt.path, dir, name, tt.wantDir, tt.wantName)
+		}
+	}
+}
+
+func TestRun_Merge_OnConflict(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "a.pdf")
+	if err := createTestPDF(input); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	output := filepath.Join(testDir, "merged.pdf")
+	if code, result, stderr := runCLI(t, "merge", "-o", output, input); code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+
+	code, result, _ := runCLI(t, "merge", "-on-conflict", "rename", "-o", output, input)
+	renamed := filepath.Join(testDir, "merged (2).pdf")
+	if code != exitOK || len(result.Outputs) != 1 || result.Outputs[0] != renamed {
+		t.Errorf("Expected output %s, got code %d and %+v", renamed, code, result)
+	}
+
+	code, result, _ = runCLI(t, "merge", "-on-conflict", "fail", "-o", output, input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "OUTPUT_EXISTS" {
+		t.Errorf("Expected OUTPUT_EXISTS failure, got code %d and %+v", code, result.ErrorInfo)
+	}
+}
+
+func TestRun_Encrypt(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "protected.pdf")
+	code, result, stderr := runCLI(t, "encrypt", "-o", output, "-user-password", "user", "-owner-password", "owner", "-allow", "print,copy", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if result.Result == nil || len(result.Result.Outputs) != 1 || result.Result.Outputs[0].PageCount != 2 {
+		t.Errorf("Expected one 2-page output, got %+v", result.Result)
+	}
+}
+
+func TestRun_EncryptUnknownPermission(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createTestPDF(input); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	code, _, _ := runCLI(t, "encrypt", "-o", filepath.Join(testDir, "out.pdf"), "-owner-password", "owner", "-allow", "fly", input)
+	if code != exitUsage {
+		t.Errorf("Expected exit code %d, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_PasswordProtectedInput(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	locked := filepath.Join(testDir, "locked.pdf")
+	if code, result, stderr := runCLI(t, "encrypt", "-o", locked, "-user-password", "user", "-owner-password", "owner", "-allow", "print,copy,modify,annotate", input); code != exitOK {
+		t.Fatalf("Failed to encrypt test PDF: %s %s", result.Error, stderr)
+	}
+
+	code, result, _ := runCLI(t, "info", locked)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "PDF_ENCRYPTED" {
+		t.Errorf("Expected PDF_ENCRYPTED without a password, got %d %+v", code, result.ErrorInfo)
+	}
+
+	code, result, _ = runCLI(t, "info", "-password", locked+"=wrong", locked)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "INCORRECT_PASSWORD" {
+		t.Errorf("Expected INCORRECT_PASSWORD, got %d %+v", code, result.ErrorInfo)
+	}
+
+	output := filepath.Join(testDir, "merged.pdf")
+	code, result, stderr := runCLI(t, "merge", "-o", output, "-password", locked+"=user", "-decrypt", locked, input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if n, err := api.PageCountFile(output); err != nil || n != 4 {
+		t.Errorf("Expected an unprotected 4-page PDF, got %d pages (%v)", n, err)
+	}
+}
+
+func TestRun_PermissionsAndDecrypt(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	locked := filepath.Join(testDir, "locked.pdf")
+	if code, result, stderr := runCLI(t, "encrypt", "-o", locked, "-owner-password", "owner", input); code != exitOK {
+		t.Fatalf("Failed to encrypt test PDF: %s %s", result.Error, stderr)
+	}
+
+	rekeyed := filepath.Join(testDir, "rekeyed.pdf")
+	code, result, stderr := runCLI(t, "permissions", "-o", rekeyed, "-owner-password", "owner", "-allow", "print", "-new-user-password", "open", locked)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+
+	code, result, _ = runCLI(t, "decrypt", "-o", filepath.Join(testDir, "fail.pdf"), rekeyed)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "PDF_ENCRYPTED" {
+		t.Errorf("Expected PDF_ENCRYPTED without a password, got %d %+v", code, result.ErrorInfo)
+	}
+
+	output := filepath.Join(testDir, "open.pdf")
+	code, result, stderr = runCLI(t, "decrypt", "-o", output, "-password", rekeyed+"=open", rekeyed)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if n, err := api.PageCountFile(output); err != nil || n != 2 {
+		t.Errorf("Expected an unprotected 2-page PDF, got %d pages (%v)", n, err)
+	}
+}
+
+func TestRun_Optimize(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "small.pdf")
+	code, result, stderr := runCLI(t, "optimize", "-o", output, input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if result.Result == nil || result.Result.SizeBefore == 0 || result.Result.SizeAfter == 0 {
+		t.Errorf("Expected before/after sizes, got %+v", result.Result)
+	}
+
+	merged := filepath.Join(testDir, "merged.pdf")
+	code, result, stderr = runCLI(t, "merge", "-o", merged, "-optimize", input, input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if result.Result == nil || result.Result.SizeBefore == 0 || result.Result.Outputs[0].PageCount != 4 {
+		t.Errorf("Expected an optimized 4-page merge, got %+v", result.Result)
+	}
+}
+
+func TestRun_Extract(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 5); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "picked.pdf")
+	code, result, stderr := runCLI(t, "extract", "-o", output, "-pages", "last,1,2-3", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if n, err := api.PageCountFile(output); err != nil || n != 4 {
+		t.Errorf("Expected 4 pages, got %d (%v)", n, err)
+	}
+
+	code, _, _ = runCLI(t, "extract", "-o", output, input)
+	if code != exitUsage {
+		t.Errorf("Expected exit code %d without -pages, got %d", exitUsage, code)
+	}
+	code, result, _ = runCLI(t, "extract", "-o", output, "-pages", "6", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "INVALID_PAGE_RANGE" {
+		t.Errorf("Expected exit code %d with INVALID_PAGE_RANGE, got %d (%+v)", exitFailure, code, result.ErrorInfo)
+	}
+}
+
+func TestRun_Delete(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 4); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "trimmed.pdf")
+	code, result, stderr := runCLI(t, "delete", "-o", output, "-pages", "1,last", input)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d (error: %s %s)", exitOK, code, result.Error, stderr)
+	}
+	if n, err := api.PageCountFile(output); err != nil || n != 2 {
+		t.Errorf("Expected 2 pages, got %d (%v)", n, err)
+	}
+
+	code, result, _ = runCLI(t, "delete", "-o", output, "-pages", "1-4", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "DELETE_ALL_PAGES" {
+		t.Errorf("Expected exit code %d with DELETE_ALL_PAGES, got %d (%+v)", exitFailure, code, result.ErrorInfo)
+	}
+}
+
+func TestRun_Reorder(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 4); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "reordered.pdf")
+	for _, flags := range [][]string{{"-order", "4,1-3"}, {"-move", "4:before:1"}, {"-reverse"}} {
+		args := append(append([]string{"reorder", "-o", output, "-on-conflict", "overwrite"}, flags...), input)
+		code, result, stderr := runCLI(t, args...)
+		if code != exitOK {
+			t.Fatalf("%v: expected exit code %d, got %d (error: %s %s)", flags, exitOK, code, result.Error, stderr)
+		}
+		if n, err := api.PageCountFile(output); err != nil || n != 4 {
+			t.Errorf("%v: expected 4 pages, got %d (%v)", flags, n, err)
+		}
+	}
+
+	code, result, _ := runCLI(t, "reorder", "-o", output, "-order", "1,2", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "REORDER_MISSING_PAGES" {
+		t.Errorf("Expected exit code %d with REORDER_MISSING_PAGES, got %d (%+v)", exitFailure, code, result.ErrorInfo)
+	}
+	if code, _, _ := runCLI(t, "reorder", "-o", output, "-move", "4-before-1", input); code != exitUsage {
+		t.Errorf("Expected exit code %d for a malformed -move, got %d", exitUsage, code)
+	}
+}
+
+func TestRun_Insert(t *testing.T) {
+	testDir := t.TempDir()
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(input, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	output := filepath.Join(testDir, "inserted.pdf")
+	for _, tt := range []struct {
+		flags []string
+		pages int
+	}{
+		{[]string{"-after", "3", "-blank", "2"}, 5},
+		{[]string{"-before", "1", "-from", input, "-from-pages", "2-last"}, 5},
+		{[]string{"-after", "1", "-from", input}, 6},
+	} {
+		args := append(append([]string{"insert", "-o", output, "-on-conflict", "overwrite"}, tt.flags...), input)
+		code, result, stderr := runCLI(t, args...)
+		if code != exitOK {
+			t.Fatalf("%v: expected exit code %d, got %d (error: %s %s)", tt.flags, exitOK, code, result.Error, stderr)
+		}
+		if n, err := api.PageCountFile(output); err != nil || n != tt.pages {
+			t.Errorf("%v: expected %d pages, got %d (%v)", tt.flags, tt.pages, n, err)
+		}
+	}
+
+	code, result, _ := runCLI(t, "insert", "-o", output, "-on-conflict", "overwrite", "-after", "4", "-blank", "1", input)
+	if code != exitFailure || result.ErrorInfo == nil || result.ErrorInfo.Code != "PAGE_OUT_OF_RANGE" {
+		t.Errorf("Expected exit code %d with PAGE_OUT_OF_RANGE, got %d (%+v)", exitFailure, code, result.ErrorInfo)
+	}
+	if code, _, _ := runCLI(t, "insert", "-o", output, "-before", "1", "-after", "1", "-blank", "1", input); code != exitUsage {
+		t.Errorf("Expected exit code %d for both -before and -after, got %d", exitUsage, code)
+	}
+	if code, _, _ := runCLI(t, "insert", "-o", output, "-after", "1", input); code != exitUsage {
+		t.Errorf("Expected exit code %d without -blank or -from, got %d", exitUsage, code)
+	}
+}
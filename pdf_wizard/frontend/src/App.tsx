@@ -1,6 +1,6 @@
 import { useState, useEffect, useRef } from 'react';
 import './App.css';
-import { Box, Tabs, Tab, AppBar, Toolbar, Typography } from '@mui/material';
+import { Alert, Box, Tabs, Tab, AppBar, Toolbar, Typography } from '@mui/material';
 import { MergeTab } from './components/MergeTab';
 import { SplitTab } from './components/SplitTab';
 import { RotateTab } from './components/RotateTab';
@@ -8,7 +8,7 @@ import { WatermarkTab } from './components/WatermarkTab';
 import { SettingsDialog } from './components/SettingsDialog';
 import logo from './assets/img/app_logo.png';
 import { OnFileDrop, OnFileDropOff, EventsOn } from '../wailsjs/runtime/runtime';
-import { GetLanguage, SetLanguage } from '../wailsjs/go/main/App';
+import { GetLanguage, GetRecoveredFiles, SetLanguage } from '../wailsjs/go/main/App';
 import { t, setLanguage, type Language } from './utils/i18n';
 import { isValidLanguage } from './utils/i18n/constants';
 
@@ -38,6 +38,7 @@ function TabPanel(props: TabPanelProps) {
 export const App = () => {
   const [tabValue, setTabValue] = useState(0);
   const [settingsOpen, setSettingsOpen] = useState(false);
+  const [recoveredFiles, setRecoveredFiles] = useState<string[]>([]);
   const [, forceUpdate] = useState({});
   const tabValueRef = useRef(0);
   const mergeTabDropHandler = useRef<((paths: string[]) => void) | null>(null);
@@ -61,6 +62,13 @@ export const App = () => {
     loadLanguage();
   }, []);
 
+  // Tell the user about files restored after an interrupted operation
+  useEffect(() => {
+    GetRecoveredFiles()
+      .then((files) => setRecoveredFiles(files || []))
+      .catch((err) => console.error('Failed to load recovered files:', err));
+  }, []);
+
   // Listen for settings event from menu
   useEffect(() => {
     const unsubscribe = EventsOn('show-settings', () => {
@@ -169,6 +177,11 @@ export const App = () => {
           </Box>
         </Toolbar>
       </AppBar>
+      {recoveredFiles.length > 0 && (
+        <Alert severity="info" onClose={() => setRecoveredFiles([])} sx={{ m: 2, mb: 0 }}>
+          {t('recoveredFiles')} {recoveredFiles.join(', ')}
+        </Alert>
+      )}
       <Box sx={{ flex: 1, overflow: 'hidden', backgroundColor: '#ffffff' }}>
         <TabPanel value={tabValue} index={0}>
           <MergeTab onFileDrop={(handler: (paths: string[]) => void) => (mergeTabDropHandler.current = handler)} />
@@ -30,6 +30,8 @@ import { useErrorHandler } from '../hooks/useErrorHandler';
 import { FilenameInput } from './FilenameInput';
 import { OutputDirectorySelector } from './OutputDirectorySelector';
 import { NoPDFSelected } from './NoPDFSelected';
+import { getErrorMessage } from '../utils/errors';
+import { DEFAULT_MERGE_OPTIONS, DEFAULT_OUTPUT_OPTIONS } from '../utils/constants';
 
 interface MergeTabProps {
   onFileDrop: (handler: (paths: string[]) => void) => void;
@@ -188,14 +190,13 @@ export const MergeTab = ({ onFileDrop }: MergeTabProps) => {
 
     try {
       const filePaths = files.map((f) => f.path);
-      await MergePDFs(filePaths, outputDirectory, outputFilename.trim());
+      await MergePDFs(filePaths, outputDirectory, outputFilename.trim(), DEFAULT_MERGE_OPTIONS, DEFAULT_OUTPUT_OPTIONS);
       setSuccess(`${t('pdfsMergedSuccessfully')} ${outputDirectory}/${outputFilename}.pdf`);
       // Clear files after successful merge
       setFiles([]);
       setOutputFilename('merged');
     } catch (err: unknown) {
-      const errorMessage =
-        err instanceof Error ? err.message : typeof err === 'string' ? err : String(err) || 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('mergeFailed')} ${errorMessage}`);
     } finally {
       setIsProcessing(false);
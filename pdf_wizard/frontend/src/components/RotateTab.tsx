@@ -22,7 +22,7 @@ import { SelectPDFFile, GetPDFMetadata, RotatePDF } from '../../wailsjs/go/main/
 import { SelectedPDF, RotateDefinition } from '../types';
 import { models } from '../../wailsjs/go/models';
 import { t } from '../utils/i18n';
-import { MAX_ROTATIONS } from '../utils/constants';
+import { DEFAULT_OUTPUT_OPTIONS, MAX_ROTATIONS } from '../utils/constants';
 import { usePDFDrop } from '../hooks/usePDFDrop';
 import { useOutputDirectory } from '../hooks/useOutputDirectory';
 import { useErrorHandler } from '../hooks/useErrorHandler';
@@ -30,6 +30,7 @@ import { PDFInfoCard } from './PDFInfoCard';
 import { FilenameInput } from './FilenameInput';
 import { OutputDirectorySelector } from './OutputDirectorySelector';
 import { NoPDFSelected } from './NoPDFSelected';
+import { getErrorMessage } from '../utils/errors';
 
 interface RotateTabProps {
   onFileDrop: (handler: (paths: string[]) => void) => void;
@@ -142,14 +143,14 @@ export const RotateTab = ({ onFileDrop }: RotateTabProps) => {
         rotation: rotation.rotation,
       }));
 
-      await RotatePDF(selectedPDF.path, rotateDefinitions, outputDirectory, outputFilename.trim());
+      await RotatePDF(selectedPDF.path, rotateDefinitions, outputDirectory, outputFilename.trim(), DEFAULT_OUTPUT_OPTIONS);
       setSuccess(`${t('pdfRotatedSuccessfully')} ${outputDirectory}/${outputFilename.trim()}.pdf`);
       // Clear selected PDF, rotations, and reset filename after successful rotation
       setSelectedPDF(null);
       setRotations([]);
       setOutputFilename('rotated');
     } catch (err) {
-      const errorMessage = err instanceof Error ? err.message : err?.toString() || 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('rotateFailed')} ${errorMessage}`);
     } finally {
       setIsProcessing(false);
@@ -12,10 +12,17 @@ import {
   Box,
   SelectChangeEvent,
 } from '@mui/material';
-import { GetLanguage, SetLanguage } from '../../wailsjs/go/main/App';
+import { GetLanguage, SetLanguage, GetConflictPolicy, SetConflictPolicy } from '../../wailsjs/go/main/App';
 import { t, setLanguage, getLanguage, getNativeLanguageName, type Language } from '../utils/i18n';
 import { SUPPORTED_LANGUAGES, isValidLanguage } from '../utils/i18n/constants';
 
+const CONFLICT_POLICIES = [
+  { value: 'overwrite', labelKey: 'conflictPolicyOverwrite' },
+  { value: 'rename', labelKey: 'conflictPolicyRename' },
+  { value: 'skip', labelKey: 'conflictPolicySkip' },
+  { value: 'fail', labelKey: 'conflictPolicyFail' },
+] as const;
+
 interface SettingsDialogProps {
   open: boolean;
   onClose: () => void;
@@ -24,12 +31,14 @@ interface SettingsDialogProps {
 
 export const SettingsDialog = ({ open, onClose, onLanguageChange }: SettingsDialogProps) => {
   const [selectedLanguage, setSelectedLanguage] = useState<Language>('en');
+  const [selectedConflictPolicy, setSelectedConflictPolicy] = useState('overwrite');
   const [loading, setLoading] = useState(false);
 
-  // Load current language setting when dialog opens
+  // Load current settings when dialog opens
   useEffect(() => {
     if (open) {
       loadLanguage();
+      loadConflictPolicy();
     }
   }, [open]);
 
@@ -44,28 +53,42 @@ export const SettingsDialog = ({ open, onClose, onLanguageChange }: SettingsDial
     }
   };
 
+  const loadConflictPolicy = async () => {
+    try {
+      setSelectedConflictPolicy(await GetConflictPolicy());
+    } catch (err) {
+      console.error('Failed to load conflict policy:', err);
+    }
+  };
+
   const handleLanguageChange = (event: SelectChangeEvent<string>) => {
     const newLanguage = event.target.value as Language;
     setSelectedLanguage(newLanguage);
   };
 
+  const handleConflictPolicyChange = (event: SelectChangeEvent<string>) => {
+    setSelectedConflictPolicy(event.target.value);
+  };
+
   const handleSave = async () => {
     setLoading(true);
     try {
       await SetLanguage(selectedLanguage);
+      await SetConflictPolicy(selectedConflictPolicy);
       setLanguage(selectedLanguage);
       onLanguageChange(selectedLanguage);
       onClose();
     } catch (err) {
-      console.error('Failed to save language:', err);
+      console.error('Failed to save settings:', err);
     } finally {
       setLoading(false);
     }
   };
 
   const handleCancel = () => {
-    // Reset to current language
+    // Reset to current settings
     loadLanguage();
+    loadConflictPolicy();
     onClose();
   };
 
@@ -91,6 +114,23 @@ export const SettingsDialog = ({ open, onClose, onLanguageChange }: SettingsDial
               ))}
             </Select>
           </FormControl>
+          <FormControl fullWidth sx={{ mt: 3 }}>
+            <InputLabel id="conflict-policy-select-label">{t('conflictPolicy')}</InputLabel>
+            <Select
+              labelId="conflict-policy-select-label"
+              id="conflict-policy-select"
+              value={selectedConflictPolicy}
+              label={t('conflictPolicy')}
+              onChange={handleConflictPolicyChange}
+              disabled={loading}
+            >
+              {CONFLICT_POLICIES.map((policy) => (
+                <MenuItem key={policy.value} value={policy.value}>
+                  {t(policy.labelKey)}
+                </MenuItem>
+              ))}
+            </Select>
+          </FormControl>
         </Box>
       </DialogContent>
       <DialogActions>
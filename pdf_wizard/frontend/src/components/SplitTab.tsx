@@ -18,13 +18,14 @@ import { SelectPDFFile, GetPDFMetadata, SplitPDF } from '../../wailsjs/go/main/A
 import { SelectedPDF, SplitDefinition } from '../types';
 import { models } from '../../wailsjs/go/models';
 import { t } from '../utils/i18n';
-import { MAX_SPLITS } from '../utils/constants';
+import { DEFAULT_OUTPUT_OPTIONS, MAX_SPLITS } from '../utils/constants';
 import { usePDFDrop } from '../hooks/usePDFDrop';
 import { useOutputDirectory } from '../hooks/useOutputDirectory';
 import { useErrorHandler } from '../hooks/useErrorHandler';
 import { PDFInfoCard } from './PDFInfoCard';
 import { OutputDirectorySelector } from './OutputDirectorySelector';
 import { NoPDFSelected } from './NoPDFSelected';
+import { getErrorMessage } from '../utils/errors';
 
 interface SplitTabProps {
   onFileDrop: (handler: (paths: string[]) => void) => void;
@@ -136,13 +137,13 @@ export const SplitTab = ({ onFileDrop }: SplitTabProps) => {
         filename: split.filename.trim(),
       }));
 
-      await SplitPDF(selectedPDF.path, splitDefinitions, outputDirectory);
+      await SplitPDF(selectedPDF.path, splitDefinitions, outputDirectory, DEFAULT_OUTPUT_OPTIONS);
       const outputFiles = splits.map((s) => `${s.filename.trim()}.pdf`).join(', ');
       setSuccess(`${t('pdfSplitSuccessfully')} ${splits.length} ${t('createdFiles')} ${outputFiles}`);
       // Clear splits after successful split
       setSplits([]);
     } catch (err) {
-      const errorMessage = err instanceof Error ? err.message : err?.toString() || 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('splitFailed')} ${errorMessage}`);
     } finally {
       setIsProcessing(false);
@@ -27,6 +27,8 @@ import { SelectedPDF } from '../types';
 import { formatFileSize, formatDate } from '../utils/formatters';
 import { models } from '../../wailsjs/go/models';
 import { t } from '../utils/i18n';
+import { getErrorMessage } from '../utils/errors';
+import { DEFAULT_OUTPUT_OPTIONS } from '../utils/constants';
 
 interface WatermarkTabProps {
   onFileDrop: (handler: (paths: string[]) => void) => void;
@@ -80,8 +82,7 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
       });
       setError(null);
     } catch (err: unknown) {
-      const errorMessage =
-        err instanceof Error ? err.message : typeof err === 'string' ? err : 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('failedToLoadPDFWatermark')} ${errorMessage}`);
     }
   };
@@ -101,8 +102,7 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
         setError(null);
       }
     } catch (err: unknown) {
-      const errorMessage =
-        err instanceof Error ? err.message : typeof err === 'string' ? err : 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('failedToSelectPDFWatermark')} ${errorMessage}`);
     }
   };
@@ -115,8 +115,7 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
         setError(null);
       }
     } catch (err: unknown) {
-      const errorMessage =
-        err instanceof Error ? err.message : typeof err === 'string' ? err : 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('failedToSelectOutputDirectoryWatermark')} ${errorMessage}`);
     }
   };
@@ -147,14 +146,13 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
         pageRange: pageRangeType === 'all' ? 'all' : pageRange.trim(),
       });
 
-      await ApplyWatermark(selectedPDF.path, watermark, outputDirectory, outputFilename.trim());
+      await ApplyWatermark(selectedPDF.path, watermark, outputDirectory, outputFilename.trim(), DEFAULT_OUTPUT_OPTIONS);
       setSuccess(`${t('watermarkAppliedSuccessfully')} ${outputDirectory}/${outputFilename.trim()}.pdf`);
       // Clear selected PDF and reset filename after successful watermark
       setSelectedPDF(null);
       setOutputFilename('watermarked');
     } catch (err: unknown) {
-      const errorMessage =
-        err instanceof Error ? err.message : typeof err === 'string' ? err : String(err) || 'Unknown error occurred';
+      const errorMessage = getErrorMessage(err);
       setError(`${t('watermarkFailed')} ${errorMessage}`);
     } finally {
       setIsProcessing(false);
@@ -177,6 +175,10 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
       return { isValid: false, error: 'Page range cannot be empty' };
     }
 
+    // "last" stands for the last page, like in the backend
+    const parsePage = (value: string): number =>
+      value.toLowerCase() === 'last' ? totalPages : parseInt(value, 10);
+
     const parts = range.split(',');
     for (const part of parts) {
       const trimmed = part.trim();
@@ -198,13 +200,13 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
           return { isValid: false, error: 'Start page cannot be empty' };
         }
 
-        const start = parseInt(startStr, 10);
+        const start = parsePage(startStr);
         if (isNaN(start) || start < 1 || start > totalPages) {
           return { isValid: false, error: `Start page ${startStr} is out of range (1-${totalPages})` };
         }
 
         if (endStr !== '') {
-          const end = parseInt(endStr, 10);
+          const end = parsePage(endStr);
           if (isNaN(end) || end < 1 || end > totalPages) {
             return { isValid: false, error: `End page ${endStr} is out of range (1-${totalPages})` };
           }
@@ -215,7 +217,7 @@ export const WatermarkTab = ({ onFileDrop }: WatermarkTabProps) => {
         // If endStr is empty, it's an open-ended range (e.g., "5-"), which is valid
       } else {
         // Single page number
-        const page = parseInt(trimmed, 10);
+        const page = parsePage(trimmed);
         if (isNaN(page) || page < 1 || page > totalPages) {
           return { isValid: false, error: `Page ${trimmed} is out of range (1-${totalPages})` };
         }
@@ -6,3 +6,13 @@ export const MAX_SPLITS = 10;
 export const MAX_ROTATIONS = 10;
 export const PDF_EXTENSION = '.pdf';
 
+
+/**
+ * Output options that defer to the conflict policy saved in settings
+ */
+export const DEFAULT_OUTPUT_OPTIONS = { conflictPolicy: '', decrypt: false };
+
+/**
+ * Merge options that merge the inputs as they are
+ */
+export const DEFAULT_MERGE_OPTIONS = { optimize: false, bookmarks: '', nestOutlines: false, dividerPage: false, duplexPadding: false };
@@ -0,0 +1,73 @@
+import { models } from '../../wailsjs/go/models';
+import { getErrorTemplate } from './i18n/errors';
+
+/**
+ * Check whether a rejected backend call carries a structured service error
+ * (see services.FormatError in the Go code)
+ */
+export function isErrorInfo(err: unknown): err is models.ErrorInfo {
+  return typeof err === 'object' && err !== null && 'code' in err && 'message' in err;
+}
+
+/**
+ * Replace {name} placeholders in a message template with the error's params.
+ * Unknown placeholders are kept as they are.
+ */
+export function fillErrorParams(template: string, params?: Record<string, any>): string {
+  return template.replace(/\{(\w+)\}/g, (placeholder, name: string) => {
+    const value = params?.[name];
+    if (value === undefined || value === null) {
+      return placeholder;
+    }
+    return Array.isArray(value) ? value.join(', ') : String(value);
+  });
+}
+
+/**
+ * Localize a structured service error by its code and params, followed by its
+ * cause like the backend's message. Codes without a template keep the
+ * backend's English message.
+ */
+export function formatErrorInfo(info: models.ErrorInfo): string {
+  const template = getErrorTemplate(info.code);
+  if (template === undefined) {
+    return info.message;
+  }
+  let message = fillErrorParams(template, info.params);
+  if (info.code === 'INTERNAL') {
+    // Internal errors carry the raw error text as their message
+    return `${message}: ${info.message}`;
+  }
+  if (info.cause) {
+    message += `: ${formatErrorInfo(info.cause)}`;
+  } else if (info.detail) {
+    message += `: ${info.detail}`;
+  }
+  return message;
+}
+
+/**
+ * Localize a warning of an operation result by its code and params. Codes
+ * without a template keep the backend's English message.
+ */
+export function formatWarning(warning: models.Warning): string {
+  const template = getErrorTemplate(warning.code);
+  return template === undefined ? warning.message : fillErrorParams(template, warning.params);
+}
+
+/**
+ * Extract a displayable message from any error thrown by a backend call.
+ * Structured errors are localized by their `code` and `params`.
+ */
+export function getErrorMessage(err: unknown): string {
+  if (isErrorInfo(err)) {
+    return formatErrorInfo(err);
+  }
+  if (err instanceof Error) {
+    return err.message;
+  }
+  if (typeof err === 'string') {
+    return err;
+  }
+  return String(err) || 'Unknown error occurred';
+}
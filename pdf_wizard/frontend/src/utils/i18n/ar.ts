@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const ar: Translations = {
   appTitle: 'معالج PDF',
+  recoveredFiles: 'تمت استعادة الملفات بعد عملية متقطعة:',
   mergeTab: 'دمج PDF',
   splitTab: 'تقسيم PDF',
   rotateTab: 'تدوير PDF',
@@ -9,6 +10,11 @@ export const ar: Translations = {
   language: 'اللغة',
   english: 'الإنجليزية',
   chinese: 'الصينية',
+  conflictPolicy: 'عند وجود ملف الإخراج',
+  conflictPolicyOverwrite: 'استبدال',
+  conflictPolicyRename: 'إعادة التسمية (إضافة رقم)',
+  conflictPolicySkip: 'تخطي',
+  conflictPolicyFail: 'فشل',
   selectPDFFiles: 'اختر ملفات PDF',
   dragDropHint: 'أو اسحب وأفلت ملفات PDF في أي مكان على النافذة',
   noFilesSelected: 'لم يتم اختيار ملفات',
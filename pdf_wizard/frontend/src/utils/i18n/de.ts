@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const de: Translations = {
   appTitle: 'PDF-Assistent',
+  recoveredFiles: 'Nach einem unterbrochenen Vorgang wiederhergestellt:',
   mergeTab: 'PDF zusammenführen',
   splitTab: 'PDF teilen',
   rotateTab: 'PDF drehen',
@@ -9,6 +10,11 @@ export const de: Translations = {
   language: 'Sprache',
   english: 'Englisch',
   chinese: 'Chinesisch',
+  conflictPolicy: 'Wenn die Ausgabedatei existiert',
+  conflictPolicyOverwrite: 'Überschreiben',
+  conflictPolicyRename: 'Umbenennen (Nummer anhängen)',
+  conflictPolicySkip: 'Überspringen',
+  conflictPolicyFail: 'Fehler melden',
   selectPDFFiles: 'PDF-Dateien auswählen',
   dragDropHint: 'Oder PDF-Dateien an eine beliebige Stelle im Fenster ziehen und ablegen',
   noFilesSelected: 'Keine Dateien ausgewählt',
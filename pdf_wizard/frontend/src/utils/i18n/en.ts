@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const en: Translations = {
   appTitle: 'PDF Wizard',
+  recoveredFiles: 'Restored after an interrupted operation:',
   mergeTab: 'Merge PDF',
   splitTab: 'Split PDF',
   rotateTab: 'Rotate PDF',
@@ -9,6 +10,11 @@ export const en: Translations = {
   language: 'Language',
   english: 'English',
   chinese: 'Chinese',
+  conflictPolicy: 'When output file exists',
+  conflictPolicyOverwrite: 'Overwrite',
+  conflictPolicyRename: 'Rename (add number)',
+  conflictPolicySkip: 'Skip',
+  conflictPolicyFail: 'Fail',
   selectPDFFiles: 'Select PDF Files',
   dragDropHint: 'Or drag and drop PDF files anywhere on the window',
   noFilesSelected: 'No files selected',
@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const ar: ErrorMessages = {
+  INTERNAL: 'خطأ داخلي',
+  OPERATION_CANCELLED: 'تم إلغاء العملية',
+  JOB_NOT_FOUND: 'المهمة غير موجودة: {id}',
+  INVALID_LANGUAGE: 'رمز لغة غير صالح: {language}',
+
+  FILE_PATH_EMPTY: 'لا يمكن أن يكون مسار الملف فارغًا',
+  FILE_NOT_FOUND: 'الملف غير موجود: {path}',
+  FILE_ACCESS: 'خطأ في الوصول إلى الملف {path}',
+  PATH_IS_DIRECTORY: 'المسار مجلد وليس ملفًا: {path}',
+  NOT_A_PDF: 'الملف ليس PDF: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'مجلد الإخراج غير موجود: {path}',
+  OUTPUT_DIR_ACCESS: 'خطأ في الوصول إلى مجلد الإخراج',
+  OUTPUT_NOT_DIRECTORY: 'مسار الإخراج ليس مجلدًا: {path}',
+  NO_FILE_SELECTED: 'لم يتم تحديد أي ملف',
+  PDF_READ_FAILED: 'فشلت قراءة ملف PDF',
+  PAGE_COUNT_FAILED: 'فشل الحصول على عدد الصفحات',
+
+  PDF_ENCRYPTED: '{path} محمي بكلمة مرور',
+  INCORRECT_PASSWORD: 'كلمة مرور غير صحيحة لـ {path}',
+  PDF_PERMISSION_DENIED: '{path} لا يسمح بهذه العملية دون كلمة مرور المالك',
+  DECRYPT_REQUIRED: '{path} محمي بكلمة مرور ولا يمكن لهذه العملية الإبقاء على الحماية؛ فعّل فك التشفير لكتابة إخراج غير محمي',
+
+  NO_INPUT_FILES: 'لم يتم توفير ملفات إدخال',
+  EMPTY_INPUT_PATH: 'مسار ملف فارغ عند الفهرس {index}',
+  INVALID_INPUT_FILE: 'ملف الإدخال',
+  INVALID_INPUT_FILE_AT: 'ملف الإدخال {index}',
+  UNREADABLE_INPUT_FILE: 'ملف PDF رقم {index} ({filename}) به مشكلات ولا يمكن معالجته. قد يحتوي الملف على ترميز خطوط غير صالح أو يكون تالفًا. يرجى محاولة إصلاح ملف PDF أو استخدام ملف آخر',
+  OUTPUT_FILENAME_EMPTY: 'لا يمكن أن يكون اسم ملف الإخراج فارغًا',
+  OUTPUT_NOT_CREATED: 'لم يتم إنشاء ملف الإخراج في: {path}',
+  CREATE_OUTPUT_FAILED: 'فشل إنشاء ملف مؤقت لـ {path}',
+  WRITE_OUTPUT_FAILED: 'فشلت كتابة ملف الإخراج {path}',
+  MOVE_OUTPUT_FAILED: 'فشل نقل ملف الإخراج إلى {path}',
+  INSPECT_OUTPUT_FAILED: 'فشل فحص ملف الإخراج {path}',
+  OUTPUT_EXISTS: 'ملف الإخراج موجود بالفعل: {path}',
+  INVALID_CONFLICT_POLICY: 'سياسة تعارض غير صالحة: {policy} (يجب أن تكون overwrite أو rename أو skip أو fail)',
+
+  MERGE_FONT_ENCODING: 'فشل دمج ملفات PDF بسبب مشكلات في ترميز الخطوط. قد يحتوي ملف PDF واحد أو أكثر على ترميز خطوط غير صالح (مثل ترميز NULL). يرجى إصلاح ملفات PDF المعنية قبل الدمج',
+  MERGE_FAILED: 'فشل دمج ملفات PDF',
+  MERGE_PAGE_RANGE_INVALID: 'ملف PDF رقم {index} ({filename}): نطاق صفحات غير صالح {range}',
+  INVALID_BOOKMARKS: 'إعداد إشارات مرجعية غير صالح {value} (يجب أن يكون filename أو title)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: 'لا يمكن ترتيب {fronts} وجه أمامي مع {backs} وجه خلفي؛ يجب أن يكون عدد الأوجه الخلفية مساويًا للأمامية أو أقل بواحد',
+  COLLATE_FAILED: 'فشل ترتيب ملفات PDF',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'التقسيم {index}: صفحة البداية {page} خارج النطاق (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'التقسيم {index}: صفحة النهاية {page} غير صالحة (يجب أن تكون >= صفحة البداية و <= {totalPages})',
+  SPLIT_FILENAME_EMPTY: 'التقسيم {index}: لا يمكن أن يكون اسم الملف فارغًا',
+  DUPLICATE_FILENAME: 'اسم ملف مكرر: {filename}',
+  SPLIT_FAILED: 'فشل استخراج صفحات التقسيم {index} (الصفحات {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'يجب أن يكون عدد الصفحات لكل ملف 1 على الأقل، تم تلقي {count}',
+  INVALID_FILENAME_PATTERN: 'نمط اسم ملف غير صالح {pattern}',
+  INVALID_OUTLINE_LEVEL: 'مستوى مخطط غير صالح {level} (يجب أن يكون 1 على الأقل)',
+  OUTLINE_READ_FAILED: 'فشلت قراءة الإشارات المرجعية لـ {path}',
+  NO_BOOKMARKS_AT_LEVEL: 'لا يحتوي ملف PDF على إشارات مرجعية في مستوى المخطط {level}',
+  SPLIT_MAX_SIZE_INVALID: 'يجب أن يكون الحد الأقصى لحجم الملف أكبر من 0، تم تلقي {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'الصفحة {page} وحدها حجمها {size} بايت، أكثر من الحد الأقصى البالغ {maxBytes} بايت',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'التدوير {index}: صفحة البداية {page} خارج النطاق (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'التدوير {index}: صفحة النهاية {page} غير صالحة (يجب أن تكون >= صفحة البداية و <= {totalPages})',
+  INVALID_ROTATION_ANGLE: 'التدوير {index}: زاوية تدوير غير صالحة {angle} (يجب أن تكون 90 أو -90 أو 180)',
+  ROTATE_FAILED: 'فشل تدوير صفحات التدوير {index} (الصفحات {startPage}-{endPage}، الزاوية {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'لا يمكن أن يكون نص العلامة المائية فارغًا',
+  INVALID_FONT_SIZE: 'يجب أن يكون حجم الخط 1 على الأقل',
+  INVALID_OPACITY: 'يجب أن تكون الشفافية بين 0.0 و 1.0',
+  INVALID_FONT_COLOR: 'لون خط غير صالح',
+  WATERMARK_CREATE_FAILED: 'فشل إنشاء العلامة المائية',
+  WATERMARK_FAILED: 'فشل تطبيق العلامة المائية',
+
+  OWNER_PASSWORD_EMPTY: 'لا يمكن أن تكون كلمة مرور المالك فارغة',
+  INVALID_ENCRYPTION_ALGORITHM: 'خوارزمية تشفير غير صالحة: {algorithm} (يجب أن تكون aes128 أو aes256)',
+  ENCRYPT_FAILED: 'فشل تشفير ملف PDF',
+  PDF_ALREADY_ENCRYPTED: '{path} مشفر بالفعل',
+  PDF_NOT_ENCRYPTED: '{path} غير مشفر',
+  DECRYPT_FAILED: 'فشل فك تشفير ملف PDF',
+  PERMISSION_CHANGE_EMPTY: 'لم يتم تقديم أذونات أو كلمات مرور جديدة',
+  CHANGE_PERMISSIONS_FAILED: 'فشل تغيير الأذونات',
+
+  OPTIMIZE_FAILED: 'فشل تحسين ملف PDF',
+
+  EXTRACT_PAGES_FAILED: 'فشل استخراج الصفحات',
+  DELETE_ALL_PAGES: 'لا يمكن حذف جميع الصفحات البالغ عددها {totalPages}؛ يجب أن تبقى صفحة واحدة على الأقل',
+  DELETE_PAGES_FAILED: 'فشل حذف الصفحات',
+
+  REORDER_MODE_INVALID: 'حدد خيارًا واحدًا فقط: تسلسل صفحات أو عمليات نقل أو عكس الترتيب',
+  REORDER_DUPLICATE_PAGE: 'الصفحة {page} مدرجة أكثر من مرة',
+  REORDER_MISSING_PAGES: 'تسلسل الصفحات ينقصه الصفحات {pages}',
+  MOVE_POSITION_INVALID: 'النقل {index}: موضع غير صالح {position} (يجب أن يكون before أو after)',
+  MOVE_PAGES_INVALID: 'النقل {index}: صفحات غير صالحة {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'النقل {index}: الصفحة الهدف {page} خارج النطاق (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'النقل {index}: لا يمكن أن تكون الصفحة الهدف {page} من الصفحات المنقولة',
+  REORDER_FAILED: 'فشلت إعادة ترتيب الصفحات',
+
+  INSERT_MODE_INVALID: 'حدد إما عدد الصفحات الفارغة أو ملف PDF لإدراج الصفحات منه',
+  INSERT_BLANK_COUNT_INVALID: 'عدد صفحات فارغة غير صالح {count} (يجب أن يكون 1 على الأقل)',
+  INSERT_POSITION_INVALID: 'موضع غير صالح {position} (يجب أن يكون before أو after)',
+  INSERT_PAGES_FAILED: 'فشل إدراج الصفحات',
+
+  INVALID_PAGE_RANGE: 'نطاق صفحات غير صالح',
+  PAGE_RANGE_EMPTY: 'لا يمكن أن يكون نطاق الصفحات فارغًا',
+  PAGE_RANGE_FORMAT: 'تنسيق نطاق صفحات غير صالح: {range}',
+  PAGE_RANGE_START_EMPTY: 'نطاق صفحات غير صالح: صفحة البداية فارغة',
+  INVALID_PAGE_NUMBER: 'رقم صفحة غير صالح {value}',
+  PAGE_OUT_OF_RANGE: 'الصفحة {page} خارج النطاق (1-{totalPages})',
+  END_PAGE_INVALID: 'صفحة النهاية {page} غير صالحة (يجب أن تكون >= صفحة البداية و <= {totalPages})',
+  NO_PAGES_SELECTED: 'لا توجد صفحات صالحة في النطاق',
+  INVALID_COLOR_FORMAT: 'تنسيق لون سداسي عشري غير صالح',
+
+  DUPLICATE_INPUT: 'تم تضمين {filename} أكثر من مرة',
+  COLLATE_SAME_FILE: 'الوجوه الأمامية والخلفية هي نفس الملف',
+  PAGES_NOT_IN_SPLIT: 'صفحات غير مضمنة في أي تقسيم: {pages}',
+  ROTATIONS_OVERLAP: 'التدويران {first} و{second} متداخلان؛ تُدوَّر الصفحات المتداخلة بكليهما',
+  WATERMARK_INVISIBLE: 'عتامة العلامة المائية 0؛ لن تكون العلامة المائية مرئية',
+  SAME_PASSWORDS: 'كلمتا مرور المستخدم والمالك متطابقتان؛ يمكن لأي شخص يستطيع فتح ملف PDF تغيير أذوناته',
+  OPTIMIZE_NOT_SMALLER: 'ملف PDF المحسَّن ليس أصغر من ملف الإدخال',
+  PAGE_ORDER_UNCHANGED: 'لم يتغير ترتيب الصفحات',
+};
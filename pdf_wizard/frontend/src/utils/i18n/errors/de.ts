@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const de: ErrorMessages = {
+  INTERNAL: 'interner Fehler',
+  OPERATION_CANCELLED: 'Vorgang abgebrochen',
+  JOB_NOT_FOUND: 'Auftrag nicht gefunden: {id}',
+  INVALID_LANGUAGE: 'ungültiger Sprachcode: {language}',
+
+  FILE_PATH_EMPTY: 'Dateipfad darf nicht leer sein',
+  FILE_NOT_FOUND: 'Datei nicht gefunden: {path}',
+  FILE_ACCESS: 'Fehler beim Zugriff auf die Datei {path}',
+  PATH_IS_DIRECTORY: 'Pfad ist ein Verzeichnis, keine Datei: {path}',
+  NOT_A_PDF: 'Datei ist keine PDF: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'Ausgabeverzeichnis existiert nicht: {path}',
+  OUTPUT_DIR_ACCESS: 'Fehler beim Zugriff auf das Ausgabeverzeichnis',
+  OUTPUT_NOT_DIRECTORY: 'Ausgabepfad ist kein Verzeichnis: {path}',
+  NO_FILE_SELECTED: 'keine Datei ausgewählt',
+  PDF_READ_FAILED: 'PDF konnte nicht gelesen werden',
+  PAGE_COUNT_FAILED: 'Seitenzahl konnte nicht ermittelt werden',
+
+  PDF_ENCRYPTED: '{path} ist passwortgeschützt',
+  INCORRECT_PASSWORD: 'falsches Passwort für {path}',
+  PDF_PERMISSION_DENIED: '{path} erlaubt diesen Vorgang nicht ohne das Besitzerpasswort',
+  DECRYPT_REQUIRED: '{path} ist passwortgeschützt und dieser Vorgang kann den Schutz nicht beibehalten; aktivieren Sie die Entschlüsselung, um eine ungeschützte Ausgabe zu schreiben',
+
+  NO_INPUT_FILES: 'keine Eingabedateien angegeben',
+  EMPTY_INPUT_PATH: 'leerer Dateipfad an Index {index}',
+  INVALID_INPUT_FILE: 'Eingabedatei',
+  INVALID_INPUT_FILE_AT: 'Eingabedatei {index}',
+  UNREADABLE_INPUT_FILE: 'PDF-Datei {index} ({filename}) ist fehlerhaft und kann nicht verarbeitet werden. Die Datei hat möglicherweise eine ungültige Schriftkodierung oder ist beschädigt. Bitte reparieren Sie die PDF oder verwenden Sie eine andere Datei',
+  OUTPUT_FILENAME_EMPTY: 'Ausgabedateiname darf nicht leer sein',
+  OUTPUT_NOT_CREATED: 'Ausgabedatei wurde nicht erstellt unter: {path}',
+  CREATE_OUTPUT_FAILED: 'temporäre Datei für {path} konnte nicht erstellt werden',
+  WRITE_OUTPUT_FAILED: 'Ausgabedatei {path} konnte nicht geschrieben werden',
+  MOVE_OUTPUT_FAILED: 'Ausgabedatei konnte nicht nach {path} verschoben werden',
+  INSPECT_OUTPUT_FAILED: 'Ausgabedatei {path} konnte nicht geprüft werden',
+  OUTPUT_EXISTS: 'Ausgabedatei existiert bereits: {path}',
+  INVALID_CONFLICT_POLICY: 'ungültige Konfliktregel: {policy} (muss overwrite, rename, skip oder fail sein)',
+
+  MERGE_FONT_ENCODING: 'PDFs konnten wegen Problemen mit der Schriftkodierung nicht zusammengeführt werden. Eine oder mehrere PDFs haben möglicherweise eine ungültige Schriftkodierung (z. B. NULL-Kodierung). Bitte reparieren Sie die betroffenen PDFs vor dem Zusammenführen',
+  MERGE_FAILED: 'PDFs konnten nicht zusammengeführt werden',
+  MERGE_PAGE_RANGE_INVALID: 'PDF-Datei {index} ({filename}): ungültiger Seitenbereich {range}',
+  INVALID_BOOKMARKS: 'ungültige Lesezeichen {value} (muss filename oder title sein)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: '{fronts} Vorderseiten können nicht mit {backs} Rückseiten zusammengelegt werden; es muss gleich viele Rückseiten wie Vorderseiten geben oder eine weniger',
+  COLLATE_FAILED: 'PDFs konnten nicht zusammengelegt werden',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'Teilung {index}: Startseite {page} liegt außerhalb des Bereichs (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'Teilung {index}: Endseite {page} ist ungültig (muss >= Startseite und <= {totalPages} sein)',
+  SPLIT_FILENAME_EMPTY: 'Teilung {index}: Dateiname darf nicht leer sein',
+  DUPLICATE_FILENAME: 'doppelter Dateiname: {filename}',
+  SPLIT_FAILED: 'Seiten für Teilung {index} konnten nicht extrahiert werden (Seiten {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'Seiten pro Datei müssen mindestens 1 sein, erhalten: {count}',
+  INVALID_FILENAME_PATTERN: 'ungültiges Dateinamenmuster {pattern}',
+  INVALID_OUTLINE_LEVEL: 'ungültige Gliederungsebene {level} (muss mindestens 1 sein)',
+  OUTLINE_READ_FAILED: 'Lesezeichen von {path} konnten nicht gelesen werden',
+  NO_BOOKMARKS_AT_LEVEL: 'die PDF hat keine Lesezeichen auf Gliederungsebene {level}',
+  SPLIT_MAX_SIZE_INVALID: 'maximale Dateigröße muss größer als 0 sein, erhalten: {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'Seite {page} allein ist {size} Bytes groß, mehr als das Maximum von {maxBytes} Bytes',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'Drehung {index}: Startseite {page} liegt außerhalb des Bereichs (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'Drehung {index}: Endseite {page} ist ungültig (muss >= Startseite und <= {totalPages} sein)',
+  INVALID_ROTATION_ANGLE: 'Drehung {index}: ungültiger Drehwinkel {angle} (muss 90, -90 oder 180 sein)',
+  ROTATE_FAILED: 'Seiten für Drehung {index} konnten nicht gedreht werden (Seiten {startPage}-{endPage}, Winkel {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'Wasserzeichentext darf nicht leer sein',
+  INVALID_FONT_SIZE: 'Schriftgröße muss mindestens 1 sein',
+  INVALID_OPACITY: 'Deckkraft muss zwischen 0.0 und 1.0 liegen',
+  INVALID_FONT_COLOR: 'ungültige Schriftfarbe',
+  WATERMARK_CREATE_FAILED: 'Wasserzeichen konnte nicht erstellt werden',
+  WATERMARK_FAILED: 'Wasserzeichen konnte nicht angewendet werden',
+
+  OWNER_PASSWORD_EMPTY: 'Besitzerpasswort darf nicht leer sein',
+  INVALID_ENCRYPTION_ALGORITHM: 'ungültiger Verschlüsselungsalgorithmus: {algorithm} (muss aes128 oder aes256 sein)',
+  ENCRYPT_FAILED: 'PDF konnte nicht verschlüsselt werden',
+  PDF_ALREADY_ENCRYPTED: '{path} ist bereits verschlüsselt',
+  PDF_NOT_ENCRYPTED: '{path} ist nicht verschlüsselt',
+  DECRYPT_FAILED: 'PDF konnte nicht entschlüsselt werden',
+  PERMISSION_CHANGE_EMPTY: 'keine neuen Berechtigungen oder Passwörter angegeben',
+  CHANGE_PERMISSIONS_FAILED: 'Berechtigungen konnten nicht geändert werden',
+
+  OPTIMIZE_FAILED: 'PDF konnte nicht optimiert werden',
+
+  EXTRACT_PAGES_FAILED: 'Seiten konnten nicht extrahiert werden',
+  DELETE_ALL_PAGES: 'es können nicht alle {totalPages} Seiten gelöscht werden; mindestens eine Seite muss bleiben',
+  DELETE_PAGES_FAILED: 'Seiten konnten nicht gelöscht werden',
+
+  REORDER_MODE_INVALID: 'geben Sie genau eines an: eine Seitenfolge, Verschiebungen oder Umkehren',
+  REORDER_DUPLICATE_PAGE: 'Seite {page} ist mehr als einmal aufgeführt',
+  REORDER_MISSING_PAGES: 'in der Seitenfolge fehlen die Seiten {pages}',
+  MOVE_POSITION_INVALID: 'Verschiebung {index}: ungültige Position {position} (muss before oder after sein)',
+  MOVE_PAGES_INVALID: 'Verschiebung {index}: ungültige Seiten {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'Verschiebung {index}: Zielseite {page} liegt außerhalb des Bereichs (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'Verschiebung {index}: Zielseite {page} darf keine der verschobenen Seiten sein',
+  REORDER_FAILED: 'Seiten konnten nicht neu angeordnet werden',
+
+  INSERT_MODE_INVALID: 'geben Sie entweder eine Anzahl leerer Seiten oder eine PDF an, aus der Seiten eingefügt werden',
+  INSERT_BLANK_COUNT_INVALID: 'ungültige Anzahl leerer Seiten {count} (muss mindestens 1 sein)',
+  INSERT_POSITION_INVALID: 'ungültige Position {position} (muss before oder after sein)',
+  INSERT_PAGES_FAILED: 'Seiten konnten nicht eingefügt werden',
+
+  INVALID_PAGE_RANGE: 'ungültiger Seitenbereich',
+  PAGE_RANGE_EMPTY: 'Seitenbereich darf nicht leer sein',
+  PAGE_RANGE_FORMAT: 'ungültiges Seitenbereichsformat: {range}',
+  PAGE_RANGE_START_EMPTY: 'ungültiger Seitenbereich: Startseite ist leer',
+  INVALID_PAGE_NUMBER: 'ungültige Seitenzahl {value}',
+  PAGE_OUT_OF_RANGE: 'Seite {page} liegt außerhalb des Bereichs (1-{totalPages})',
+  END_PAGE_INVALID: 'Endseite {page} ist ungültig (muss >= Startseite und <= {totalPages} sein)',
+  NO_PAGES_SELECTED: 'keine gültigen Seiten im Bereich',
+  INVALID_COLOR_FORMAT: 'ungültiges Hex-Farbformat',
+
+  DUPLICATE_INPUT: '{filename} ist mehr als einmal enthalten',
+  COLLATE_SAME_FILE: 'Vorder- und Rückseiten sind dieselbe Datei',
+  PAGES_NOT_IN_SPLIT: 'Seiten, die in keiner Teilung enthalten sind: {pages}',
+  ROTATIONS_OVERLAP: 'Drehungen {first} und {second} überschneiden sich; überlappende Seiten werden von beiden gedreht',
+  WATERMARK_INVISIBLE: 'Die Deckkraft des Wasserzeichens ist 0; das Wasserzeichen wird nicht sichtbar sein',
+  SAME_PASSWORDS: 'Benutzer- und Besitzerpasswort sind identisch; jeder, der die PDF öffnen kann, kann ihre Berechtigungen ändern',
+  OPTIMIZE_NOT_SMALLER: 'Die optimierte PDF ist nicht kleiner als die Eingabe',
+  PAGE_ORDER_UNCHANGED: 'Die Seitenreihenfolge ist unverändert',
+};
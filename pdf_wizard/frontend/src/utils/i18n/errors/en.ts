@@ -0,0 +1,122 @@
+import { ErrorMessages } from './types';
+
+// English messages; they match the backend's messages in services/errors.go
+// and its warnings in services/result.go
+export const en: ErrorMessages = {
+  INTERNAL: 'internal error',
+  OPERATION_CANCELLED: 'operation cancelled',
+  JOB_NOT_FOUND: 'job not found: {id}',
+  INVALID_LANGUAGE: 'invalid language code: {language}',
+
+  FILE_PATH_EMPTY: 'file path cannot be empty',
+  FILE_NOT_FOUND: 'file not found: {path}',
+  FILE_ACCESS: 'error accessing file {path}',
+  PATH_IS_DIRECTORY: 'path is a directory, not a file: {path}',
+  NOT_A_PDF: 'file is not a PDF: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'output directory does not exist: {path}',
+  OUTPUT_DIR_ACCESS: 'error accessing output directory',
+  OUTPUT_NOT_DIRECTORY: 'output path is not a directory: {path}',
+  NO_FILE_SELECTED: 'no file selected',
+  PDF_READ_FAILED: 'failed to read PDF',
+  PAGE_COUNT_FAILED: 'failed to get page count',
+
+  PDF_ENCRYPTED: '{path} is password protected',
+  INCORRECT_PASSWORD: 'incorrect password for {path}',
+  PDF_PERMISSION_DENIED: '{path} does not permit this operation without the owner password',
+  DECRYPT_REQUIRED: '{path} is password protected and this operation cannot keep the protection; enable decrypt to write unprotected output',
+
+  NO_INPUT_FILES: 'no input files provided',
+  EMPTY_INPUT_PATH: 'empty file path at index {index}',
+  INVALID_INPUT_FILE: 'input file',
+  INVALID_INPUT_FILE_AT: 'input file {index}',
+  UNREADABLE_INPUT_FILE: 'PDF file {index} ({filename}) has issues and cannot be processed. This file may have invalid font encoding or be corrupted. Please try repairing the PDF or use a different file',
+  OUTPUT_FILENAME_EMPTY: 'output filename cannot be empty',
+  OUTPUT_NOT_CREATED: 'output file was not created at: {path}',
+  CREATE_OUTPUT_FAILED: 'failed to create temporary file for {path}',
+  WRITE_OUTPUT_FAILED: 'failed to write output file {path}',
+  MOVE_OUTPUT_FAILED: 'failed to move output file to {path}',
+  INSPECT_OUTPUT_FAILED: 'failed to inspect output file {path}',
+  OUTPUT_EXISTS: 'output file already exists: {path}',
+  INVALID_CONFLICT_POLICY: 'invalid conflict policy: {policy} (must be overwrite, rename, skip or fail)',
+
+  MERGE_FONT_ENCODING: 'failed to merge PDFs due to font encoding issues. One or more PDFs may have invalid font encoding (e.g., NULL encoding). Please try repairing the problematic PDF(s) before merging',
+  MERGE_FAILED: 'failed to merge PDFs',
+  MERGE_PAGE_RANGE_INVALID: 'PDF file {index} ({filename}): invalid page range {range}',
+  INVALID_BOOKMARKS: 'invalid bookmarks {value} (must be filename or title)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: 'cannot collate {fronts} fronts with {backs} backs; there must be as many backs as fronts, or one less',
+  COLLATE_FAILED: 'failed to collate PDFs',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'split {index}: start page {page} is out of range (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'split {index}: end page {page} is invalid (must be >= start page and <= {totalPages})',
+  SPLIT_FILENAME_EMPTY: 'split {index}: filename cannot be empty',
+  DUPLICATE_FILENAME: 'duplicate filename: {filename}',
+  SPLIT_FAILED: 'failed to trim pages for split {index} (pages {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'pages per file must be at least 1, got {count}',
+  INVALID_FILENAME_PATTERN: 'invalid filename pattern {pattern}',
+  INVALID_OUTLINE_LEVEL: 'invalid outline level {level} (must be at least 1)',
+  OUTLINE_READ_FAILED: 'failed to read the bookmarks of {path}',
+  NO_BOOKMARKS_AT_LEVEL: 'the PDF has no bookmarks at outline level {level}',
+  SPLIT_MAX_SIZE_INVALID: 'maximum file size must be greater than 0, got {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'page {page} alone is {size} bytes, more than the maximum of {maxBytes} bytes',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'rotation {index}: start page {page} is out of range (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'rotation {index}: end page {page} is invalid (must be >= start page and <= {totalPages})',
+  INVALID_ROTATION_ANGLE: 'rotation {index}: invalid rotation angle {angle} (must be 90, -90, or 180)',
+  ROTATE_FAILED: 'failed to rotate pages for rotation {index} (pages {startPage}-{endPage}, angle {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'watermark text cannot be empty',
+  INVALID_FONT_SIZE: 'font size must be at least 1',
+  INVALID_OPACITY: 'opacity must be between 0.0 and 1.0',
+  INVALID_FONT_COLOR: 'invalid font color',
+  WATERMARK_CREATE_FAILED: 'failed to create watermark',
+  WATERMARK_FAILED: 'failed to apply watermark',
+
+  OWNER_PASSWORD_EMPTY: 'owner password cannot be empty',
+  INVALID_ENCRYPTION_ALGORITHM: 'invalid encryption algorithm: {algorithm} (must be aes128 or aes256)',
+  ENCRYPT_FAILED: 'failed to encrypt PDF',
+  PDF_ALREADY_ENCRYPTED: '{path} is already encrypted',
+  PDF_NOT_ENCRYPTED: '{path} is not encrypted',
+  DECRYPT_FAILED: 'failed to decrypt PDF',
+  PERMISSION_CHANGE_EMPTY: 'no new permissions or passwords given',
+  CHANGE_PERMISSIONS_FAILED: 'failed to change permissions',
+
+  OPTIMIZE_FAILED: 'failed to optimize PDF',
+
+  EXTRACT_PAGES_FAILED: 'failed to extract pages',
+  DELETE_ALL_PAGES: 'cannot delete all {totalPages} pages; at least one page must remain',
+  DELETE_PAGES_FAILED: 'failed to delete pages',
+
+  REORDER_MODE_INVALID: 'give exactly one of a page sequence, moves or reverse',
+  REORDER_DUPLICATE_PAGE: 'page {page} is listed more than once',
+  REORDER_MISSING_PAGES: 'the page sequence is missing pages {pages}',
+  MOVE_POSITION_INVALID: 'move {index}: invalid position {position} (must be before or after)',
+  MOVE_PAGES_INVALID: 'move {index}: invalid pages {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'move {index}: target page {page} is out of range (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'move {index}: target page {page} cannot be one of the moved pages',
+  REORDER_FAILED: 'failed to reorder pages',
+
+  INSERT_MODE_INVALID: 'give either a number of blank pages or a PDF to insert pages from',
+  INSERT_BLANK_COUNT_INVALID: 'invalid number of blank pages {count} (must be at least 1)',
+  INSERT_POSITION_INVALID: 'invalid position {position} (must be before or after)',
+  INSERT_PAGES_FAILED: 'failed to insert pages',
+
+  INVALID_PAGE_RANGE: 'invalid page range',
+  PAGE_RANGE_EMPTY: 'page range cannot be empty',
+  PAGE_RANGE_FORMAT: 'invalid page range format: {range}',
+  PAGE_RANGE_START_EMPTY: 'invalid page range: start page is empty',
+  INVALID_PAGE_NUMBER: 'invalid page number {value}',
+  PAGE_OUT_OF_RANGE: 'page {page} is out of range (1-{totalPages})',
+  END_PAGE_INVALID: 'end page {page} is invalid (must be >= start page and <= {totalPages})',
+  NO_PAGES_SELECTED: 'no valid pages in range',
+  INVALID_COLOR_FORMAT: 'invalid hex color format',
+
+  DUPLICATE_INPUT: '{filename} is included more than once',
+  COLLATE_SAME_FILE: 'the fronts and backs are the same file',
+  PAGES_NOT_IN_SPLIT: 'pages not included in any split: {pages}',
+  ROTATIONS_OVERLAP: 'rotations {first} and {second} overlap; overlapping pages are rotated by both',
+  WATERMARK_INVISIBLE: 'watermark opacity is 0; the watermark will not be visible',
+  SAME_PASSWORDS: 'user and owner passwords are the same; anyone who can open the PDF can change its permissions',
+  OPTIMIZE_NOT_SMALLER: 'the optimized PDF is not smaller than the input',
+  PAGE_ORDER_UNCHANGED: 'the page order is unchanged',
+};
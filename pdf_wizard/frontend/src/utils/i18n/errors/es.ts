@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const es: ErrorMessages = {
+  INTERNAL: 'error interno',
+  OPERATION_CANCELLED: 'operación cancelada',
+  JOB_NOT_FOUND: 'trabajo no encontrado: {id}',
+  INVALID_LANGUAGE: 'código de idioma no válido: {language}',
+
+  FILE_PATH_EMPTY: 'la ruta del archivo no puede estar vacía',
+  FILE_NOT_FOUND: 'archivo no encontrado: {path}',
+  FILE_ACCESS: 'error al acceder al archivo {path}',
+  PATH_IS_DIRECTORY: 'la ruta es una carpeta, no un archivo: {path}',
+  NOT_A_PDF: 'el archivo no es un PDF: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'la carpeta de salida no existe: {path}',
+  OUTPUT_DIR_ACCESS: 'error al acceder a la carpeta de salida',
+  OUTPUT_NOT_DIRECTORY: 'la ruta de salida no es una carpeta: {path}',
+  NO_FILE_SELECTED: 'no se seleccionó ningún archivo',
+  PDF_READ_FAILED: 'no se pudo leer el PDF',
+  PAGE_COUNT_FAILED: 'no se pudo obtener el número de páginas',
+
+  PDF_ENCRYPTED: '{path} está protegido con contraseña',
+  INCORRECT_PASSWORD: 'contraseña incorrecta para {path}',
+  PDF_PERMISSION_DENIED: '{path} no permite esta operación sin la contraseña de propietario',
+  DECRYPT_REQUIRED: '{path} está protegido con contraseña y esta operación no puede conservar la protección; active el descifrado para escribir una salida sin protección',
+
+  NO_INPUT_FILES: 'no se proporcionaron archivos de entrada',
+  EMPTY_INPUT_PATH: 'ruta de archivo vacía en el índice {index}',
+  INVALID_INPUT_FILE: 'archivo de entrada',
+  INVALID_INPUT_FILE_AT: 'archivo de entrada {index}',
+  UNREADABLE_INPUT_FILE: 'El archivo PDF {index} ({filename}) tiene problemas y no se puede procesar. Puede tener una codificación de fuente no válida o estar dañado. Intente reparar el PDF o use otro archivo',
+  OUTPUT_FILENAME_EMPTY: 'el nombre del archivo de salida no puede estar vacío',
+  OUTPUT_NOT_CREATED: 'no se creó el archivo de salida en: {path}',
+  CREATE_OUTPUT_FAILED: 'no se pudo crear el archivo temporal para {path}',
+  WRITE_OUTPUT_FAILED: 'no se pudo escribir el archivo de salida {path}',
+  MOVE_OUTPUT_FAILED: 'no se pudo mover el archivo de salida a {path}',
+  INSPECT_OUTPUT_FAILED: 'no se pudo inspeccionar el archivo de salida {path}',
+  OUTPUT_EXISTS: 'el archivo de salida ya existe: {path}',
+  INVALID_CONFLICT_POLICY: 'política de conflicto no válida: {policy} (debe ser overwrite, rename, skip o fail)',
+
+  MERGE_FONT_ENCODING: 'no se pudieron combinar los PDF por problemas de codificación de fuentes. Uno o más PDF pueden tener una codificación de fuente no válida (p. ej., codificación NULL). Intente reparar los PDF afectados antes de combinarlos',
+  MERGE_FAILED: 'no se pudieron combinar los PDF',
+  MERGE_PAGE_RANGE_INVALID: 'archivo PDF {index} ({filename}): rango de páginas no válido {range}',
+  INVALID_BOOKMARKS: 'marcadores no válidos {value} (debe ser filename o title)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: 'no se pueden intercalar {fronts} anversos con {backs} reversos; debe haber tantos reversos como anversos, o uno menos',
+  COLLATE_FAILED: 'no se pudieron intercalar los PDF',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'división {index}: la página inicial {page} está fuera de rango (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'división {index}: la página final {page} no es válida (debe ser >= página inicial y <= {totalPages})',
+  SPLIT_FILENAME_EMPTY: 'división {index}: el nombre de archivo no puede estar vacío',
+  DUPLICATE_FILENAME: 'nombre de archivo duplicado: {filename}',
+  SPLIT_FAILED: 'no se pudieron extraer las páginas de la división {index} (páginas {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'las páginas por archivo deben ser al menos 1, se recibió {count}',
+  INVALID_FILENAME_PATTERN: 'patrón de nombre de archivo no válido {pattern}',
+  INVALID_OUTLINE_LEVEL: 'nivel de esquema no válido {level} (debe ser al menos 1)',
+  OUTLINE_READ_FAILED: 'no se pudieron leer los marcadores de {path}',
+  NO_BOOKMARKS_AT_LEVEL: 'el PDF no tiene marcadores en el nivel de esquema {level}',
+  SPLIT_MAX_SIZE_INVALID: 'el tamaño máximo de archivo debe ser mayor que 0, se recibió {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'solo la página {page} ocupa {size} bytes, más que el máximo de {maxBytes} bytes',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'rotación {index}: la página inicial {page} está fuera de rango (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'rotación {index}: la página final {page} no es válida (debe ser >= página inicial y <= {totalPages})',
+  INVALID_ROTATION_ANGLE: 'rotación {index}: ángulo de rotación no válido {angle} (debe ser 90, -90 o 180)',
+  ROTATE_FAILED: 'no se pudieron girar las páginas de la rotación {index} (páginas {startPage}-{endPage}, ángulo {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'el texto de la marca de agua no puede estar vacío',
+  INVALID_FONT_SIZE: 'el tamaño de fuente debe ser al menos 1',
+  INVALID_OPACITY: 'la opacidad debe estar entre 0.0 y 1.0',
+  INVALID_FONT_COLOR: 'color de fuente no válido',
+  WATERMARK_CREATE_FAILED: 'no se pudo crear la marca de agua',
+  WATERMARK_FAILED: 'no se pudo aplicar la marca de agua',
+
+  OWNER_PASSWORD_EMPTY: 'la contraseña de propietario no puede estar vacía',
+  INVALID_ENCRYPTION_ALGORITHM: 'algoritmo de cifrado no válido: {algorithm} (debe ser aes128 o aes256)',
+  ENCRYPT_FAILED: 'no se pudo cifrar el PDF',
+  PDF_ALREADY_ENCRYPTED: '{path} ya está cifrado',
+  PDF_NOT_ENCRYPTED: '{path} no está cifrado',
+  DECRYPT_FAILED: 'no se pudo descifrar el PDF',
+  PERMISSION_CHANGE_EMPTY: 'no se indicaron nuevos permisos ni contraseñas',
+  CHANGE_PERMISSIONS_FAILED: 'no se pudieron cambiar los permisos',
+
+  OPTIMIZE_FAILED: 'no se pudo optimizar el PDF',
+
+  EXTRACT_PAGES_FAILED: 'no se pudieron extraer las páginas',
+  DELETE_ALL_PAGES: 'no se pueden eliminar las {totalPages} páginas; debe quedar al menos una página',
+  DELETE_PAGES_FAILED: 'no se pudieron eliminar las páginas',
+
+  REORDER_MODE_INVALID: 'indique exactamente una opción: una secuencia de páginas, movimientos o invertir',
+  REORDER_DUPLICATE_PAGE: 'la página {page} aparece más de una vez',
+  REORDER_MISSING_PAGES: 'faltan las páginas {pages} en la secuencia',
+  MOVE_POSITION_INVALID: 'movimiento {index}: posición no válida {position} (debe ser before o after)',
+  MOVE_PAGES_INVALID: 'movimiento {index}: páginas no válidas {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'movimiento {index}: la página de destino {page} está fuera de rango (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'movimiento {index}: la página de destino {page} no puede ser una de las páginas movidas',
+  REORDER_FAILED: 'no se pudieron reordenar las páginas',
+
+  INSERT_MODE_INVALID: 'indique un número de páginas en blanco o un PDF del que insertar páginas',
+  INSERT_BLANK_COUNT_INVALID: 'número de páginas en blanco no válido {count} (debe ser al menos 1)',
+  INSERT_POSITION_INVALID: 'posición no válida {position} (debe ser before o after)',
+  INSERT_PAGES_FAILED: 'no se pudieron insertar las páginas',
+
+  INVALID_PAGE_RANGE: 'rango de páginas no válido',
+  PAGE_RANGE_EMPTY: 'el rango de páginas no puede estar vacío',
+  PAGE_RANGE_FORMAT: 'formato de rango de páginas no válido: {range}',
+  PAGE_RANGE_START_EMPTY: 'rango de páginas no válido: la página inicial está vacía',
+  INVALID_PAGE_NUMBER: 'número de página no válido {value}',
+  PAGE_OUT_OF_RANGE: 'la página {page} está fuera de rango (1-{totalPages})',
+  END_PAGE_INVALID: 'la página final {page} no es válida (debe ser >= página inicial y <= {totalPages})',
+  NO_PAGES_SELECTED: 'no hay páginas válidas en el rango',
+  INVALID_COLOR_FORMAT: 'formato de color hexadecimal no válido',
+
+  DUPLICATE_INPUT: '{filename} se incluye más de una vez',
+  COLLATE_SAME_FILE: 'los anversos y los reversos son el mismo archivo',
+  PAGES_NOT_IN_SPLIT: 'páginas no incluidas en ninguna división: {pages}',
+  ROTATIONS_OVERLAP: 'las rotaciones {first} y {second} se superponen; las páginas superpuestas se giran con ambas',
+  WATERMARK_INVISIBLE: 'la opacidad de la marca de agua es 0; la marca de agua no será visible',
+  SAME_PASSWORDS: 'las contraseñas de usuario y de propietario son iguales; cualquiera que pueda abrir el PDF puede cambiar sus permisos',
+  OPTIMIZE_NOT_SMALLER: 'el PDF optimizado no es más pequeño que el de entrada',
+  PAGE_ORDER_UNCHANGED: 'el orden de las páginas no ha cambiado',
+};
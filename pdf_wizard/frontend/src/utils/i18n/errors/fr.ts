@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const fr: ErrorMessages = {
+  INTERNAL: 'erreur interne',
+  OPERATION_CANCELLED: 'opération annulée',
+  JOB_NOT_FOUND: 'tâche introuvable : {id}',
+  INVALID_LANGUAGE: 'code de langue non valide : {language}',
+
+  FILE_PATH_EMPTY: 'le chemin du fichier ne peut pas être vide',
+  FILE_NOT_FOUND: 'fichier introuvable : {path}',
+  FILE_ACCESS: 'erreur d\'accès au fichier {path}',
+  PATH_IS_DIRECTORY: 'le chemin est un dossier, pas un fichier : {path}',
+  NOT_A_PDF: 'le fichier n\'est pas un PDF : {path}',
+  OUTPUT_DIR_NOT_FOUND: 'le dossier de sortie n\'existe pas : {path}',
+  OUTPUT_DIR_ACCESS: 'erreur d\'accès au dossier de sortie',
+  OUTPUT_NOT_DIRECTORY: 'le chemin de sortie n\'est pas un dossier : {path}',
+  NO_FILE_SELECTED: 'aucun fichier sélectionné',
+  PDF_READ_FAILED: 'échec de la lecture du PDF',
+  PAGE_COUNT_FAILED: 'échec de l\'obtention du nombre de pages',
+
+  PDF_ENCRYPTED: '{path} est protégé par mot de passe',
+  INCORRECT_PASSWORD: 'mot de passe incorrect pour {path}',
+  PDF_PERMISSION_DENIED: '{path} n\'autorise pas cette opération sans le mot de passe propriétaire',
+  DECRYPT_REQUIRED: '{path} est protégé par mot de passe et cette opération ne peut pas conserver la protection ; activez le déchiffrement pour écrire une sortie non protégée',
+
+  NO_INPUT_FILES: 'aucun fichier d\'entrée fourni',
+  EMPTY_INPUT_PATH: 'chemin de fichier vide à l\'index {index}',
+  INVALID_INPUT_FILE: 'fichier d\'entrée',
+  INVALID_INPUT_FILE_AT: 'fichier d\'entrée {index}',
+  UNREADABLE_INPUT_FILE: 'Le fichier PDF {index} ({filename}) présente des problèmes et ne peut pas être traité. Il contient peut-être un encodage de police non valide ou est endommagé. Essayez de réparer le PDF ou utilisez un autre fichier',
+  OUTPUT_FILENAME_EMPTY: 'le nom du fichier de sortie ne peut pas être vide',
+  OUTPUT_NOT_CREATED: 'le fichier de sortie n\'a pas été créé à : {path}',
+  CREATE_OUTPUT_FAILED: 'échec de la création du fichier temporaire pour {path}',
+  WRITE_OUTPUT_FAILED: 'échec de l\'écriture du fichier de sortie {path}',
+  MOVE_OUTPUT_FAILED: 'échec du déplacement du fichier de sortie vers {path}',
+  INSPECT_OUTPUT_FAILED: 'échec de l\'inspection du fichier de sortie {path}',
+  OUTPUT_EXISTS: 'le fichier de sortie existe déjà : {path}',
+  INVALID_CONFLICT_POLICY: 'politique de conflit non valide : {policy} (doit être overwrite, rename, skip ou fail)',
+
+  MERGE_FONT_ENCODING: 'échec de la fusion des PDF en raison de problèmes d\'encodage de police. Un ou plusieurs PDF contiennent peut-être un encodage de police non valide (par ex. encodage NULL). Essayez de réparer les PDF concernés avant de les fusionner',
+  MERGE_FAILED: 'échec de la fusion des PDF',
+  MERGE_PAGE_RANGE_INVALID: 'fichier PDF {index} ({filename}) : plage de pages non valide {range}',
+  INVALID_BOOKMARKS: 'signets non valides {value} (doit être filename ou title)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: 'impossible d\'assembler {fronts} rectos avec {backs} versos ; il doit y avoir autant de versos que de rectos, ou un de moins',
+  COLLATE_FAILED: 'échec de l\'assemblage des PDF',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'division {index} : la page de début {page} est hors limites (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'division {index} : la page de fin {page} n\'est pas valide (doit être >= page de début et <= {totalPages})',
+  SPLIT_FILENAME_EMPTY: 'division {index} : le nom de fichier ne peut pas être vide',
+  DUPLICATE_FILENAME: 'nom de fichier en double : {filename}',
+  SPLIT_FAILED: 'échec de l\'extraction des pages de la division {index} (pages {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'le nombre de pages par fichier doit être au moins 1, reçu {count}',
+  INVALID_FILENAME_PATTERN: 'modèle de nom de fichier non valide {pattern}',
+  INVALID_OUTLINE_LEVEL: 'niveau de plan non valide {level} (doit être au moins 1)',
+  OUTLINE_READ_FAILED: 'échec de la lecture des signets de {path}',
+  NO_BOOKMARKS_AT_LEVEL: 'le PDF n\'a aucun signet au niveau de plan {level}',
+  SPLIT_MAX_SIZE_INVALID: 'la taille maximale du fichier doit être supérieure à 0, reçu {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'la page {page} fait à elle seule {size} octets, plus que le maximum de {maxBytes} octets',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'rotation {index} : la page de début {page} est hors limites (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'rotation {index} : la page de fin {page} n\'est pas valide (doit être >= page de début et <= {totalPages})',
+  INVALID_ROTATION_ANGLE: 'rotation {index} : angle de rotation non valide {angle} (doit être 90, -90 ou 180)',
+  ROTATE_FAILED: 'échec de la rotation des pages de la rotation {index} (pages {startPage}-{endPage}, angle {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'le texte du filigrane ne peut pas être vide',
+  INVALID_FONT_SIZE: 'la taille de police doit être au moins 1',
+  INVALID_OPACITY: 'l\'opacité doit être comprise entre 0.0 et 1.0',
+  INVALID_FONT_COLOR: 'couleur de police non valide',
+  WATERMARK_CREATE_FAILED: 'échec de la création du filigrane',
+  WATERMARK_FAILED: 'échec de l\'application du filigrane',
+
+  OWNER_PASSWORD_EMPTY: 'le mot de passe propriétaire ne peut pas être vide',
+  INVALID_ENCRYPTION_ALGORITHM: 'algorithme de chiffrement non valide : {algorithm} (doit être aes128 ou aes256)',
+  ENCRYPT_FAILED: 'échec du chiffrement du PDF',
+  PDF_ALREADY_ENCRYPTED: '{path} est déjà chiffré',
+  PDF_NOT_ENCRYPTED: '{path} n\'est pas chiffré',
+  DECRYPT_FAILED: 'échec du déchiffrement du PDF',
+  PERMISSION_CHANGE_EMPTY: 'aucune nouvelle autorisation ni aucun nouveau mot de passe fourni',
+  CHANGE_PERMISSIONS_FAILED: 'échec de la modification des autorisations',
+
+  OPTIMIZE_FAILED: 'échec de l\'optimisation du PDF',
+
+  EXTRACT_PAGES_FAILED: 'échec de l\'extraction des pages',
+  DELETE_ALL_PAGES: 'impossible de supprimer les {totalPages} pages ; au moins une page doit rester',
+  DELETE_PAGES_FAILED: 'échec de la suppression des pages',
+
+  REORDER_MODE_INVALID: 'indiquez exactement une séquence de pages, des déplacements ou l\'inversion',
+  REORDER_DUPLICATE_PAGE: 'la page {page} apparaît plus d\'une fois',
+  REORDER_MISSING_PAGES: 'il manque les pages {pages} dans la séquence',
+  MOVE_POSITION_INVALID: 'déplacement {index} : position non valide {position} (doit être before ou after)',
+  MOVE_PAGES_INVALID: 'déplacement {index} : pages non valides {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'déplacement {index} : la page cible {page} est hors limites (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'déplacement {index} : la page cible {page} ne peut pas faire partie des pages déplacées',
+  REORDER_FAILED: 'échec de la réorganisation des pages',
+
+  INSERT_MODE_INVALID: 'indiquez soit un nombre de pages blanches, soit un PDF dont insérer les pages',
+  INSERT_BLANK_COUNT_INVALID: 'nombre de pages blanches non valide {count} (doit être au moins 1)',
+  INSERT_POSITION_INVALID: 'position non valide {position} (doit être before ou after)',
+  INSERT_PAGES_FAILED: 'échec de l\'insertion des pages',
+
+  INVALID_PAGE_RANGE: 'plage de pages non valide',
+  PAGE_RANGE_EMPTY: 'la plage de pages ne peut pas être vide',
+  PAGE_RANGE_FORMAT: 'format de plage de pages non valide : {range}',
+  PAGE_RANGE_START_EMPTY: 'plage de pages non valide : la page de début est vide',
+  INVALID_PAGE_NUMBER: 'numéro de page non valide {value}',
+  PAGE_OUT_OF_RANGE: 'la page {page} est hors limites (1-{totalPages})',
+  END_PAGE_INVALID: 'la page de fin {page} n\'est pas valide (doit être >= page de début et <= {totalPages})',
+  NO_PAGES_SELECTED: 'aucune page valide dans la plage',
+  INVALID_COLOR_FORMAT: 'format de couleur hexadécimal non valide',
+
+  DUPLICATE_INPUT: '{filename} est inclus plus d\'une fois',
+  COLLATE_SAME_FILE: 'les recto et les verso sont le même fichier',
+  PAGES_NOT_IN_SPLIT: 'pages incluses dans aucune division : {pages}',
+  ROTATIONS_OVERLAP: 'les rotations {first} et {second} se chevauchent ; les pages communes sont pivotées par les deux',
+  WATERMARK_INVISIBLE: 'l\'opacité du filigrane est 0 ; le filigrane ne sera pas visible',
+  SAME_PASSWORDS: 'les mots de passe utilisateur et propriétaire sont identiques ; toute personne pouvant ouvrir le PDF peut modifier ses autorisations',
+  OPTIMIZE_NOT_SMALLER: 'le PDF optimisé n\'est pas plus petit que le fichier d\'entrée',
+  PAGE_ORDER_UNCHANGED: 'l\'ordre des pages est inchangé',
+};
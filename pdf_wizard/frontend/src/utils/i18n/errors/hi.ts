@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const hi: ErrorMessages = {
+  INTERNAL: 'आंतरिक त्रुटि',
+  OPERATION_CANCELLED: 'ऑपरेशन रद्द किया गया',
+  JOB_NOT_FOUND: 'कार्य नहीं मिला: {id}',
+  INVALID_LANGUAGE: 'अमान्य भाषा कोड: {language}',
+
+  FILE_PATH_EMPTY: 'फ़ाइल पथ खाली नहीं हो सकता',
+  FILE_NOT_FOUND: 'फ़ाइल नहीं मिली: {path}',
+  FILE_ACCESS: 'फ़ाइल {path} तक पहुँचने में त्रुटि',
+  PATH_IS_DIRECTORY: 'पथ एक फ़ोल्डर है, फ़ाइल नहीं: {path}',
+  NOT_A_PDF: 'फ़ाइल PDF नहीं है: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'आउटपुट फ़ोल्डर मौजूद नहीं है: {path}',
+  OUTPUT_DIR_ACCESS: 'आउटपुट फ़ोल्डर तक पहुँचने में त्रुटि',
+  OUTPUT_NOT_DIRECTORY: 'आउटपुट पथ फ़ोल्डर नहीं है: {path}',
+  NO_FILE_SELECTED: 'कोई फ़ाइल चयनित नहीं',
+  PDF_READ_FAILED: 'PDF पढ़ने में विफल',
+  PAGE_COUNT_FAILED: 'पृष्ठ संख्या प्राप्त करने में विफल',
+
+  PDF_ENCRYPTED: '{path} पासवर्ड से सुरक्षित है',
+  INCORRECT_PASSWORD: '{path} के लिए गलत पासवर्ड',
+  PDF_PERMISSION_DENIED: '{path} स्वामी पासवर्ड के बिना इस ऑपरेशन की अनुमति नहीं देता',
+  DECRYPT_REQUIRED: '{path} पासवर्ड से सुरक्षित है और यह ऑपरेशन सुरक्षा बनाए नहीं रख सकता; असुरक्षित आउटपुट लिखने के लिए डिक्रिप्ट सक्षम करें',
+
+  NO_INPUT_FILES: 'कोई इनपुट फ़ाइल नहीं दी गई',
+  EMPTY_INPUT_PATH: 'इंडेक्स {index} पर फ़ाइल पथ खाली है',
+  INVALID_INPUT_FILE: 'इनपुट फ़ाइल',
+  INVALID_INPUT_FILE_AT: 'इनपुट फ़ाइल {index}',
+  UNREADABLE_INPUT_FILE: 'PDF फ़ाइल {index} ({filename}) में समस्याएँ हैं और इसे संसाधित नहीं किया जा सकता। इस फ़ाइल में अमान्य फ़ॉन्ट एन्कोडिंग हो सकती है या यह दूषित हो सकती है। कृपया PDF की मरम्मत करें या किसी अन्य फ़ाइल का उपयोग करें',
+  OUTPUT_FILENAME_EMPTY: 'आउटपुट फ़ाइल नाम खाली नहीं हो सकता',
+  OUTPUT_NOT_CREATED: 'आउटपुट फ़ाइल यहाँ नहीं बनाई गई: {path}',
+  CREATE_OUTPUT_FAILED: '{path} के लिए अस्थायी फ़ाइल बनाने में विफल',
+  WRITE_OUTPUT_FAILED: 'आउटपुट फ़ाइल {path} लिखने में विफल',
+  MOVE_OUTPUT_FAILED: 'आउटपुट फ़ाइल को {path} पर ले जाने में विफल',
+  INSPECT_OUTPUT_FAILED: 'आउटपुट फ़ाइल {path} की जाँच करने में विफल',
+  OUTPUT_EXISTS: 'आउटपुट फ़ाइल पहले से मौजूद है: {path}',
+  INVALID_CONFLICT_POLICY: 'अमान्य विरोध नीति: {policy} (overwrite, rename, skip या fail होनी चाहिए)',
+
+  MERGE_FONT_ENCODING: 'फ़ॉन्ट एन्कोडिंग समस्याओं के कारण PDF मर्ज करने में विफल। एक या अधिक PDF में अमान्य फ़ॉन्ट एन्कोडिंग (जैसे NULL एन्कोडिंग) हो सकती है। कृपया मर्ज करने से पहले समस्याग्रस्त PDF की मरम्मत करें',
+  MERGE_FAILED: 'PDF मर्ज करने में विफल',
+  MERGE_PAGE_RANGE_INVALID: 'PDF फ़ाइल {index} ({filename}): अमान्य पृष्ठ श्रेणी {range}',
+  INVALID_BOOKMARKS: 'अमान्य बुकमार्क सेटिंग {value} (filename या title होनी चाहिए)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: '{fronts} सामने के पृष्ठों को {backs} पीछे के पृष्ठों के साथ क्रमबद्ध नहीं किया जा सकता; पीछे के पृष्ठ सामने के बराबर या एक कम होने चाहिए',
+  COLLATE_FAILED: 'PDF क्रमबद्ध करने में विफल',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'विभाजन {index}: प्रारंभ पृष्ठ {page} सीमा से बाहर है (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'विभाजन {index}: अंतिम पृष्ठ {page} अमान्य है (प्रारंभ पृष्ठ से >= और {totalPages} से <= होना चाहिए)',
+  SPLIT_FILENAME_EMPTY: 'विभाजन {index}: फ़ाइल नाम खाली नहीं हो सकता',
+  DUPLICATE_FILENAME: 'डुप्लिकेट फ़ाइल नाम: {filename}',
+  SPLIT_FAILED: 'विभाजन {index} के पृष्ठ निकालने में विफल (पृष्ठ {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'प्रति फ़ाइल पृष्ठ कम से कम 1 होने चाहिए, मिला {count}',
+  INVALID_FILENAME_PATTERN: 'अमान्य फ़ाइल नाम पैटर्न {pattern}',
+  INVALID_OUTLINE_LEVEL: 'अमान्य आउटलाइन स्तर {level} (कम से कम 1 होना चाहिए)',
+  OUTLINE_READ_FAILED: '{path} के बुकमार्क पढ़ने में विफल',
+  NO_BOOKMARKS_AT_LEVEL: 'PDF में आउटलाइन स्तर {level} पर कोई बुकमार्क नहीं है',
+  SPLIT_MAX_SIZE_INVALID: 'अधिकतम फ़ाइल आकार 0 से बड़ा होना चाहिए, मिला {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'केवल पृष्ठ {page} ही {size} बाइट का है, जो अधिकतम {maxBytes} बाइट से अधिक है',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'घुमाव {index}: प्रारंभ पृष्ठ {page} सीमा से बाहर है (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'घुमाव {index}: अंतिम पृष्ठ {page} अमान्य है (प्रारंभ पृष्ठ से >= और {totalPages} से <= होना चाहिए)',
+  INVALID_ROTATION_ANGLE: 'घुमाव {index}: अमान्य घुमाव कोण {angle} (90, -90 या 180 होना चाहिए)',
+  ROTATE_FAILED: 'घुमाव {index} के पृष्ठ घुमाने में विफल (पृष्ठ {startPage}-{endPage}, कोण {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'वॉटरमार्क टेक्स्ट खाली नहीं हो सकता',
+  INVALID_FONT_SIZE: 'फ़ॉन्ट आकार कम से कम 1 होना चाहिए',
+  INVALID_OPACITY: 'अपारदर्शिता 0.0 और 1.0 के बीच होनी चाहिए',
+  INVALID_FONT_COLOR: 'अमान्य फ़ॉन्ट रंग',
+  WATERMARK_CREATE_FAILED: 'वॉटरमार्क बनाने में विफल',
+  WATERMARK_FAILED: 'वॉटरमार्क लागू करने में विफल',
+
+  OWNER_PASSWORD_EMPTY: 'स्वामी पासवर्ड खाली नहीं हो सकता',
+  INVALID_ENCRYPTION_ALGORITHM: 'अमान्य एन्क्रिप्शन एल्गोरिदम: {algorithm} (aes128 या aes256 होना चाहिए)',
+  ENCRYPT_FAILED: 'PDF एन्क्रिप्ट करने में विफल',
+  PDF_ALREADY_ENCRYPTED: '{path} पहले से एन्क्रिप्टेड है',
+  PDF_NOT_ENCRYPTED: '{path} एन्क्रिप्टेड नहीं है',
+  DECRYPT_FAILED: 'PDF डिक्रिप्ट करने में विफल',
+  PERMISSION_CHANGE_EMPTY: 'कोई नई अनुमति या पासवर्ड नहीं दिया गया',
+  CHANGE_PERMISSIONS_FAILED: 'अनुमतियाँ बदलने में विफल',
+
+  OPTIMIZE_FAILED: 'PDF अनुकूलित करने में विफल',
+
+  EXTRACT_PAGES_FAILED: 'पृष्ठ निकालने में विफल',
+  DELETE_ALL_PAGES: 'सभी {totalPages} पृष्ठ हटाए नहीं जा सकते; कम से कम एक पृष्ठ रहना चाहिए',
+  DELETE_PAGES_FAILED: 'पृष्ठ हटाने में विफल',
+
+  REORDER_MODE_INVALID: 'पृष्ठ क्रम, स्थानांतरण या उल्टा क्रम में से ठीक एक दें',
+  REORDER_DUPLICATE_PAGE: 'पृष्ठ {page} एक से अधिक बार सूचीबद्ध है',
+  REORDER_MISSING_PAGES: 'पृष्ठ क्रम में पृष्ठ {pages} नहीं हैं',
+  MOVE_POSITION_INVALID: 'स्थानांतरण {index}: अमान्य स्थिति {position} (before या after होनी चाहिए)',
+  MOVE_PAGES_INVALID: 'स्थानांतरण {index}: अमान्य पृष्ठ {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'स्थानांतरण {index}: लक्ष्य पृष्ठ {page} सीमा से बाहर है (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'स्थानांतरण {index}: लक्ष्य पृष्ठ {page} स्थानांतरित पृष्ठों में से एक नहीं हो सकता',
+  REORDER_FAILED: 'पृष्ठों का क्रम बदलने में विफल',
+
+  INSERT_MODE_INVALID: 'या तो रिक्त पृष्ठों की संख्या दें या वह PDF जिससे पृष्ठ डालने हैं',
+  INSERT_BLANK_COUNT_INVALID: 'रिक्त पृष्ठों की अमान्य संख्या {count} (कम से कम 1 होनी चाहिए)',
+  INSERT_POSITION_INVALID: 'अमान्य स्थिति {position} (before या after होनी चाहिए)',
+  INSERT_PAGES_FAILED: 'पृष्ठ डालने में विफल',
+
+  INVALID_PAGE_RANGE: 'अमान्य पृष्ठ श्रेणी',
+  PAGE_RANGE_EMPTY: 'पृष्ठ श्रेणी खाली नहीं हो सकती',
+  PAGE_RANGE_FORMAT: 'अमान्य पृष्ठ श्रेणी प्रारूप: {range}',
+  PAGE_RANGE_START_EMPTY: 'अमान्य पृष्ठ श्रेणी: प्रारंभ पृष्ठ खाली है',
+  INVALID_PAGE_NUMBER: 'अमान्य पृष्ठ संख्या {value}',
+  PAGE_OUT_OF_RANGE: 'पृष्ठ {page} सीमा से बाहर है (1-{totalPages})',
+  END_PAGE_INVALID: 'अंतिम पृष्ठ {page} अमान्य है (प्रारंभ पृष्ठ से >= और {totalPages} से <= होना चाहिए)',
+  NO_PAGES_SELECTED: 'श्रेणी में कोई मान्य पृष्ठ नहीं',
+  INVALID_COLOR_FORMAT: 'अमान्य हेक्स रंग प्रारूप',
+
+  DUPLICATE_INPUT: '{filename} एक से अधिक बार शामिल है',
+  COLLATE_SAME_FILE: 'आगे और पीछे के पृष्ठ एक ही फ़ाइल हैं',
+  PAGES_NOT_IN_SPLIT: 'किसी भी विभाजन में शामिल नहीं किए गए पृष्ठ: {pages}',
+  ROTATIONS_OVERLAP: 'घुमाव {first} और {second} ओवरलैप करते हैं; ओवरलैप होने वाले पृष्ठ दोनों द्वारा घुमाए जाते हैं',
+  WATERMARK_INVISIBLE: 'वॉटरमार्क की अपारदर्शिता 0 है; वॉटरमार्क दिखाई नहीं देगा',
+  SAME_PASSWORDS: 'उपयोगकर्ता और स्वामी पासवर्ड समान हैं; PDF खोल सकने वाला कोई भी व्यक्ति इसकी अनुमतियाँ बदल सकता है',
+  OPTIMIZE_NOT_SMALLER: 'अनुकूलित PDF इनपुट से छोटा नहीं है',
+  PAGE_ORDER_UNCHANGED: 'पृष्ठ क्रम अपरिवर्तित है',
+};
@@ -0,0 +1,39 @@
+import { getLanguage } from '../index';
+import { Language } from '../types';
+import { ErrorMessages } from './types';
+import { en } from './en';
+import { zh } from './zh';
+import { zhTW } from './zh-TW';
+import { ar } from './ar';
+import { fr } from './fr';
+import { ja } from './ja';
+import { hi } from './hi';
+import { es } from './es';
+import { pt } from './pt';
+import { ru } from './ru';
+import { ko } from './ko';
+import { de } from './de';
+
+// Codes missing from a catalog fall back to English
+const errorMessages: Record<Language, ErrorMessages> = {
+  en,
+  zh,
+  'zh-TW': zhTW,
+  ar,
+  fr,
+  ja,
+  hi,
+  es,
+  pt,
+  ru,
+  ko,
+  de,
+};
+
+// getErrorTemplate returns the message template of a backend error code in the
+// current language, or undefined for codes the frontend does not know
+export const getErrorTemplate = (code: string): string | undefined => {
+  return errorMessages[getLanguage()][code] ?? en[code];
+};
+
+export type { ErrorMessages };
@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const ja: ErrorMessages = {
+  INTERNAL: '内部エラー',
+  OPERATION_CANCELLED: '操作がキャンセルされました',
+  JOB_NOT_FOUND: 'ジョブが見つかりません: {id}',
+  INVALID_LANGUAGE: '無効な言語コード: {language}',
+
+  FILE_PATH_EMPTY: 'ファイルパスを空にすることはできません',
+  FILE_NOT_FOUND: 'ファイルが見つかりません: {path}',
+  FILE_ACCESS: 'ファイル {path} へのアクセス中にエラーが発生しました',
+  PATH_IS_DIRECTORY: 'パスはファイルではなくフォルダーです: {path}',
+  NOT_A_PDF: 'ファイルは PDF ではありません: {path}',
+  OUTPUT_DIR_NOT_FOUND: '出力フォルダーが存在しません: {path}',
+  OUTPUT_DIR_ACCESS: '出力フォルダーへのアクセス中にエラーが発生しました',
+  OUTPUT_NOT_DIRECTORY: '出力パスはフォルダーではありません: {path}',
+  NO_FILE_SELECTED: 'ファイルが選択されていません',
+  PDF_READ_FAILED: 'PDF の読み込みに失敗しました',
+  PAGE_COUNT_FAILED: 'ページ数の取得に失敗しました',
+
+  PDF_ENCRYPTED: '{path} はパスワードで保護されています',
+  INCORRECT_PASSWORD: '{path} のパスワードが正しくありません',
+  PDF_PERMISSION_DENIED: '{path} ではオーナーパスワードなしでこの操作は許可されていません',
+  DECRYPT_REQUIRED: '{path} はパスワードで保護されており、この操作では保護を維持できません。保護なしで出力するには復号を有効にしてください',
+
+  NO_INPUT_FILES: '入力ファイルが指定されていません',
+  EMPTY_INPUT_PATH: 'インデックス {index} のファイルパスが空です',
+  INVALID_INPUT_FILE: '入力ファイル',
+  INVALID_INPUT_FILE_AT: '入力ファイル {index}',
+  UNREADABLE_INPUT_FILE: 'PDF ファイル {index}（{filename}）に問題があり、処理できません。フォントエンコーディングが無効か、ファイルが破損している可能性があります。PDF を修復するか、別のファイルを使用してください',
+  OUTPUT_FILENAME_EMPTY: '出力ファイル名を空にすることはできません',
+  OUTPUT_NOT_CREATED: '出力ファイルが作成されませんでした: {path}',
+  CREATE_OUTPUT_FAILED: '{path} の一時ファイルの作成に失敗しました',
+  WRITE_OUTPUT_FAILED: '出力ファイル {path} の書き込みに失敗しました',
+  MOVE_OUTPUT_FAILED: '出力ファイルを {path} に移動できませんでした',
+  INSPECT_OUTPUT_FAILED: '出力ファイル {path} の検査に失敗しました',
+  OUTPUT_EXISTS: '出力ファイルは既に存在します: {path}',
+  INVALID_CONFLICT_POLICY: '無効な競合ポリシー: {policy}（overwrite、rename、skip、fail のいずれか）',
+
+  MERGE_FONT_ENCODING: 'フォントエンコーディングの問題により PDF を結合できませんでした。1 つ以上の PDF に無効なフォントエンコーディング（NULL エンコーディングなど）が含まれている可能性があります。結合する前に問題のある PDF を修復してください',
+  MERGE_FAILED: 'PDF の結合に失敗しました',
+  MERGE_PAGE_RANGE_INVALID: 'PDF ファイル {index}（{filename}）: 無効なページ範囲 {range}',
+  INVALID_BOOKMARKS: '無効なしおり設定 {value}（filename または title）',
+
+  COLLATE_PAGE_COUNT_MISMATCH: '表面 {fronts} ページと裏面 {backs} ページを丁合できません。裏面は表面と同数か 1 ページ少ない必要があります',
+  COLLATE_FAILED: 'PDF の丁合に失敗しました',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: '分割 {index}: 開始ページ {page} が範囲外です（1-{totalPages}）',
+  SPLIT_END_PAGE_INVALID: '分割 {index}: 終了ページ {page} が無効です（開始ページ以上かつ {totalPages} 以下）',
+  SPLIT_FILENAME_EMPTY: '分割 {index}: ファイル名を空にすることはできません',
+  DUPLICATE_FILENAME: 'ファイル名が重複しています: {filename}',
+  SPLIT_FAILED: '分割 {index} のページの抽出に失敗しました（{startPage}-{endPage} ページ）',
+  SPLIT_PAGES_PER_FILE_INVALID: 'ファイルあたりのページ数は 1 以上である必要があります（指定値: {count}）',
+  INVALID_FILENAME_PATTERN: '無効なファイル名パターン {pattern}',
+  INVALID_OUTLINE_LEVEL: '無効なアウトラインレベル {level}（1 以上）',
+  OUTLINE_READ_FAILED: '{path} のしおりの読み込みに失敗しました',
+  NO_BOOKMARKS_AT_LEVEL: 'この PDF にはアウトラインレベル {level} のしおりがありません',
+  SPLIT_MAX_SIZE_INVALID: '最大ファイルサイズは 0 より大きい必要があります（指定値: {maxBytes}）',
+  SPLIT_PAGE_TOO_LARGE: '{page} ページだけで {size} バイトあり、上限の {maxBytes} バイトを超えています',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: '回転 {index}: 開始ページ {page} が範囲外です（1-{totalPages}）',
+  ROTATION_END_PAGE_INVALID: '回転 {index}: 終了ページ {page} が無効です（開始ページ以上かつ {totalPages} 以下）',
+  INVALID_ROTATION_ANGLE: '回転 {index}: 無効な回転角度 {angle}（90、-90、180 のいずれか）',
+  ROTATE_FAILED: '回転 {index} のページの回転に失敗しました（{startPage}-{endPage} ページ、角度 {angle}）',
+
+  WATERMARK_TEXT_EMPTY: '透かしのテキストを空にすることはできません',
+  INVALID_FONT_SIZE: 'フォントサイズは 1 以上である必要があります',
+  INVALID_OPACITY: '不透明度は 0.0 から 1.0 の間である必要があります',
+  INVALID_FONT_COLOR: '無効なフォント色',
+  WATERMARK_CREATE_FAILED: '透かしの作成に失敗しました',
+  WATERMARK_FAILED: '透かしの適用に失敗しました',
+
+  OWNER_PASSWORD_EMPTY: 'オーナーパスワードを空にすることはできません',
+  INVALID_ENCRYPTION_ALGORITHM: '無効な暗号化アルゴリズム: {algorithm}（aes128 または aes256）',
+  ENCRYPT_FAILED: 'PDF の暗号化に失敗しました',
+  PDF_ALREADY_ENCRYPTED: '{path} は既に暗号化されています',
+  PDF_NOT_ENCRYPTED: '{path} は暗号化されていません',
+  DECRYPT_FAILED: 'PDF の復号に失敗しました',
+  PERMISSION_CHANGE_EMPTY: '新しい権限またはパスワードが指定されていません',
+  CHANGE_PERMISSIONS_FAILED: '権限の変更に失敗しました',
+
+  OPTIMIZE_FAILED: 'PDF の最適化に失敗しました',
+
+  EXTRACT_PAGES_FAILED: 'ページの抽出に失敗しました',
+  DELETE_ALL_PAGES: '全 {totalPages} ページを削除することはできません。少なくとも 1 ページは残す必要があります',
+  DELETE_PAGES_FAILED: 'ページの削除に失敗しました',
+
+  REORDER_MODE_INVALID: 'ページ順序、移動、逆順のいずれか 1 つだけを指定してください',
+  REORDER_DUPLICATE_PAGE: '{page} ページが複数回指定されています',
+  REORDER_MISSING_PAGES: 'ページ順序に {pages} ページがありません',
+  MOVE_POSITION_INVALID: '移動 {index}: 無効な位置 {position}（before または after）',
+  MOVE_PAGES_INVALID: '移動 {index}: 無効なページ {range}',
+  MOVE_TARGET_OUT_OF_RANGE: '移動 {index}: 移動先ページ {page} が範囲外です（1-{totalPages}）',
+  MOVE_TARGET_MOVED: '移動 {index}: 移動先ページ {page} を移動するページに含めることはできません',
+  REORDER_FAILED: 'ページの並べ替えに失敗しました',
+
+  INSERT_MODE_INVALID: '空白ページの数か、ページを挿入する PDF のいずれかを指定してください',
+  INSERT_BLANK_COUNT_INVALID: '無効な空白ページ数 {count}（1 以上）',
+  INSERT_POSITION_INVALID: '無効な位置 {position}（before または after）',
+  INSERT_PAGES_FAILED: 'ページの挿入に失敗しました',
+
+  INVALID_PAGE_RANGE: '無効なページ範囲',
+  PAGE_RANGE_EMPTY: 'ページ範囲を空にすることはできません',
+  PAGE_RANGE_FORMAT: '無効なページ範囲の形式: {range}',
+  PAGE_RANGE_START_EMPTY: '無効なページ範囲: 開始ページが空です',
+  INVALID_PAGE_NUMBER: '無効なページ番号 {value}',
+  PAGE_OUT_OF_RANGE: '{page} ページは範囲外です（1-{totalPages}）',
+  END_PAGE_INVALID: '終了ページ {page} が無効です（開始ページ以上かつ {totalPages} 以下）',
+  NO_PAGES_SELECTED: '範囲内に有効なページがありません',
+  INVALID_COLOR_FORMAT: '無効な 16 進数カラー形式',
+
+  DUPLICATE_INPUT: '{filename} が複数回含まれています',
+  COLLATE_SAME_FILE: '表面と裏面が同じファイルです',
+  PAGES_NOT_IN_SPLIT: 'どの分割にも含まれていないページ: {pages}',
+  ROTATIONS_OVERLAP: '回転 {first} と {second} が重複しています。重複するページは両方で回転されます',
+  WATERMARK_INVISIBLE: '透かしの不透明度が 0 です。透かしは表示されません',
+  SAME_PASSWORDS: 'ユーザーパスワードと所有者パスワードが同じです。PDF を開ける人は誰でも権限を変更できます',
+  OPTIMIZE_NOT_SMALLER: '最適化された PDF は入力より小さくなっていません',
+  PAGE_ORDER_UNCHANGED: 'ページの順序は変更されていません',
+};
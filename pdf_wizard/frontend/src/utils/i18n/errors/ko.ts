@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const ko: ErrorMessages = {
+  INTERNAL: '내부 오류',
+  OPERATION_CANCELLED: '작업이 취소되었습니다',
+  JOB_NOT_FOUND: '작업을 찾을 수 없습니다: {id}',
+  INVALID_LANGUAGE: '잘못된 언어 코드: {language}',
+
+  FILE_PATH_EMPTY: '파일 경로는 비워 둘 수 없습니다',
+  FILE_NOT_FOUND: '파일을 찾을 수 없습니다: {path}',
+  FILE_ACCESS: '파일 {path}에 액세스하는 중 오류가 발생했습니다',
+  PATH_IS_DIRECTORY: '경로가 파일이 아니라 폴더입니다: {path}',
+  NOT_A_PDF: '파일이 PDF가 아닙니다: {path}',
+  OUTPUT_DIR_NOT_FOUND: '출력 폴더가 존재하지 않습니다: {path}',
+  OUTPUT_DIR_ACCESS: '출력 폴더에 액세스하는 중 오류가 발생했습니다',
+  OUTPUT_NOT_DIRECTORY: '출력 경로가 폴더가 아닙니다: {path}',
+  NO_FILE_SELECTED: '선택한 파일이 없습니다',
+  PDF_READ_FAILED: 'PDF를 읽지 못했습니다',
+  PAGE_COUNT_FAILED: '페이지 수를 가져오지 못했습니다',
+
+  PDF_ENCRYPTED: '{path}은(는) 암호로 보호되어 있습니다',
+  INCORRECT_PASSWORD: '{path}의 암호가 올바르지 않습니다',
+  PDF_PERMISSION_DENIED: '{path}은(는) 소유자 암호 없이 이 작업을 허용하지 않습니다',
+  DECRYPT_REQUIRED: '{path}은(는) 암호로 보호되어 있으며 이 작업은 보호를 유지할 수 없습니다. 보호되지 않은 출력을 쓰려면 암호 해제를 활성화하세요',
+
+  NO_INPUT_FILES: '입력 파일이 제공되지 않았습니다',
+  EMPTY_INPUT_PATH: '인덱스 {index}의 파일 경로가 비어 있습니다',
+  INVALID_INPUT_FILE: '입력 파일',
+  INVALID_INPUT_FILE_AT: '입력 파일 {index}',
+  UNREADABLE_INPUT_FILE: 'PDF 파일 {index}({filename})에 문제가 있어 처리할 수 없습니다. 글꼴 인코딩이 잘못되었거나 파일이 손상되었을 수 있습니다. PDF를 복구하거나 다른 파일을 사용하세요',
+  OUTPUT_FILENAME_EMPTY: '출력 파일 이름은 비워 둘 수 없습니다',
+  OUTPUT_NOT_CREATED: '출력 파일이 생성되지 않았습니다: {path}',
+  CREATE_OUTPUT_FAILED: '{path}의 임시 파일을 만들지 못했습니다',
+  WRITE_OUTPUT_FAILED: '출력 파일 {path}을(를) 쓰지 못했습니다',
+  MOVE_OUTPUT_FAILED: '출력 파일을 {path}(으)로 이동하지 못했습니다',
+  INSPECT_OUTPUT_FAILED: '출력 파일 {path}을(를) 검사하지 못했습니다',
+  OUTPUT_EXISTS: '출력 파일이 이미 존재합니다: {path}',
+  INVALID_CONFLICT_POLICY: '잘못된 충돌 정책: {policy} (overwrite, rename, skip 또는 fail이어야 합니다)',
+
+  MERGE_FONT_ENCODING: '글꼴 인코딩 문제로 PDF를 병합하지 못했습니다. 하나 이상의 PDF에 잘못된 글꼴 인코딩(예: NULL 인코딩)이 있을 수 있습니다. 병합하기 전에 문제가 있는 PDF를 복구하세요',
+  MERGE_FAILED: 'PDF를 병합하지 못했습니다',
+  MERGE_PAGE_RANGE_INVALID: 'PDF 파일 {index}({filename}): 잘못된 페이지 범위 {range}',
+  INVALID_BOOKMARKS: '잘못된 책갈피 설정 {value} (filename 또는 title이어야 합니다)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: '앞면 {fronts}페이지와 뒷면 {backs}페이지를 교차 병합할 수 없습니다. 뒷면은 앞면과 같거나 한 페이지 적어야 합니다',
+  COLLATE_FAILED: 'PDF를 교차 병합하지 못했습니다',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: '분할 {index}: 시작 페이지 {page}이(가) 범위를 벗어났습니다 (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: '분할 {index}: 끝 페이지 {page}이(가) 잘못되었습니다 (시작 페이지 이상, {totalPages} 이하여야 합니다)',
+  SPLIT_FILENAME_EMPTY: '분할 {index}: 파일 이름은 비워 둘 수 없습니다',
+  DUPLICATE_FILENAME: '중복된 파일 이름: {filename}',
+  SPLIT_FAILED: '분할 {index}의 페이지를 추출하지 못했습니다 ({startPage}-{endPage}페이지)',
+  SPLIT_PAGES_PER_FILE_INVALID: '파일당 페이지 수는 1 이상이어야 합니다. 입력값: {count}',
+  INVALID_FILENAME_PATTERN: '잘못된 파일 이름 패턴 {pattern}',
+  INVALID_OUTLINE_LEVEL: '잘못된 개요 수준 {level} (1 이상이어야 합니다)',
+  OUTLINE_READ_FAILED: '{path}의 책갈피를 읽지 못했습니다',
+  NO_BOOKMARKS_AT_LEVEL: 'PDF에 개요 수준 {level}의 책갈피가 없습니다',
+  SPLIT_MAX_SIZE_INVALID: '최대 파일 크기는 0보다 커야 합니다. 입력값: {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: '{page}페이지 하나만으로 {size}바이트이며, 최대 {maxBytes}바이트를 초과합니다',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: '회전 {index}: 시작 페이지 {page}이(가) 범위를 벗어났습니다 (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: '회전 {index}: 끝 페이지 {page}이(가) 잘못되었습니다 (시작 페이지 이상, {totalPages} 이하여야 합니다)',
+  INVALID_ROTATION_ANGLE: '회전 {index}: 잘못된 회전 각도 {angle} (90, -90 또는 180이어야 합니다)',
+  ROTATE_FAILED: '회전 {index}의 페이지를 회전하지 못했습니다 ({startPage}-{endPage}페이지, 각도 {angle})',
+
+  WATERMARK_TEXT_EMPTY: '워터마크 텍스트는 비워 둘 수 없습니다',
+  INVALID_FONT_SIZE: '글꼴 크기는 1 이상이어야 합니다',
+  INVALID_OPACITY: '불투명도는 0.0에서 1.0 사이여야 합니다',
+  INVALID_FONT_COLOR: '잘못된 글꼴 색상',
+  WATERMARK_CREATE_FAILED: '워터마크를 만들지 못했습니다',
+  WATERMARK_FAILED: '워터마크를 적용하지 못했습니다',
+
+  OWNER_PASSWORD_EMPTY: '소유자 암호는 비워 둘 수 없습니다',
+  INVALID_ENCRYPTION_ALGORITHM: '잘못된 암호화 알고리즘: {algorithm} (aes128 또는 aes256이어야 합니다)',
+  ENCRYPT_FAILED: 'PDF를 암호화하지 못했습니다',
+  PDF_ALREADY_ENCRYPTED: '{path}은(는) 이미 암호화되어 있습니다',
+  PDF_NOT_ENCRYPTED: '{path}은(는) 암호화되어 있지 않습니다',
+  DECRYPT_FAILED: 'PDF의 암호를 해제하지 못했습니다',
+  PERMISSION_CHANGE_EMPTY: '새 권한이나 암호가 지정되지 않았습니다',
+  CHANGE_PERMISSIONS_FAILED: '권한을 변경하지 못했습니다',
+
+  OPTIMIZE_FAILED: 'PDF를 최적화하지 못했습니다',
+
+  EXTRACT_PAGES_FAILED: '페이지를 추출하지 못했습니다',
+  DELETE_ALL_PAGES: '{totalPages}페이지를 모두 삭제할 수 없습니다. 최소 한 페이지는 남아 있어야 합니다',
+  DELETE_PAGES_FAILED: '페이지를 삭제하지 못했습니다',
+
+  REORDER_MODE_INVALID: '페이지 순서, 이동, 역순 중 정확히 하나만 지정하세요',
+  REORDER_DUPLICATE_PAGE: '{page}페이지가 두 번 이상 나열되었습니다',
+  REORDER_MISSING_PAGES: '페이지 순서에 {pages}페이지가 없습니다',
+  MOVE_POSITION_INVALID: '이동 {index}: 잘못된 위치 {position} (before 또는 after여야 합니다)',
+  MOVE_PAGES_INVALID: '이동 {index}: 잘못된 페이지 {range}',
+  MOVE_TARGET_OUT_OF_RANGE: '이동 {index}: 대상 페이지 {page}이(가) 범위를 벗어났습니다 (1-{totalPages})',
+  MOVE_TARGET_MOVED: '이동 {index}: 대상 페이지 {page}은(는) 이동하는 페이지에 포함될 수 없습니다',
+  REORDER_FAILED: '페이지 순서를 변경하지 못했습니다',
+
+  INSERT_MODE_INVALID: '빈 페이지 수 또는 페이지를 삽입할 PDF 중 하나를 지정하세요',
+  INSERT_BLANK_COUNT_INVALID: '잘못된 빈 페이지 수 {count} (1 이상이어야 합니다)',
+  INSERT_POSITION_INVALID: '잘못된 위치 {position} (before 또는 after여야 합니다)',
+  INSERT_PAGES_FAILED: '페이지를 삽입하지 못했습니다',
+
+  INVALID_PAGE_RANGE: '잘못된 페이지 범위',
+  PAGE_RANGE_EMPTY: '페이지 범위는 비워 둘 수 없습니다',
+  PAGE_RANGE_FORMAT: '잘못된 페이지 범위 형식: {range}',
+  PAGE_RANGE_START_EMPTY: '잘못된 페이지 범위: 시작 페이지가 비어 있습니다',
+  INVALID_PAGE_NUMBER: '잘못된 페이지 번호 {value}',
+  PAGE_OUT_OF_RANGE: '{page}페이지가 범위를 벗어났습니다 (1-{totalPages})',
+  END_PAGE_INVALID: '끝 페이지 {page}이(가) 잘못되었습니다 (시작 페이지 이상, {totalPages} 이하여야 합니다)',
+  NO_PAGES_SELECTED: '범위에 유효한 페이지가 없습니다',
+  INVALID_COLOR_FORMAT: '잘못된 16진수 색상 형식',
+
+  DUPLICATE_INPUT: '{filename}이(가) 두 번 이상 포함되었습니다',
+  COLLATE_SAME_FILE: '앞면과 뒷면이 같은 파일입니다',
+  PAGES_NOT_IN_SPLIT: '어떤 분할에도 포함되지 않은 페이지: {pages}',
+  ROTATIONS_OVERLAP: '회전 {first}과(와) {second}이(가) 겹칩니다. 겹치는 페이지는 두 회전이 모두 적용됩니다',
+  WATERMARK_INVISIBLE: '워터마크 불투명도가 0입니다. 워터마크가 보이지 않습니다',
+  SAME_PASSWORDS: '사용자 암호와 소유자 암호가 같습니다. PDF를 열 수 있는 사람은 누구나 권한을 변경할 수 있습니다',
+  OPTIMIZE_NOT_SMALLER: '최적화된 PDF가 입력 파일보다 작지 않습니다',
+  PAGE_ORDER_UNCHANGED: '페이지 순서가 변경되지 않았습니다',
+};
@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const pt: ErrorMessages = {
+  INTERNAL: 'erro interno',
+  OPERATION_CANCELLED: 'operação cancelada',
+  JOB_NOT_FOUND: 'tarefa não encontrada: {id}',
+  INVALID_LANGUAGE: 'código de idioma inválido: {language}',
+
+  FILE_PATH_EMPTY: 'o caminho do arquivo não pode estar vazio',
+  FILE_NOT_FOUND: 'arquivo não encontrado: {path}',
+  FILE_ACCESS: 'erro ao acessar o arquivo {path}',
+  PATH_IS_DIRECTORY: 'o caminho é uma pasta, não um arquivo: {path}',
+  NOT_A_PDF: 'o arquivo não é um PDF: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'a pasta de saída não existe: {path}',
+  OUTPUT_DIR_ACCESS: 'erro ao acessar a pasta de saída',
+  OUTPUT_NOT_DIRECTORY: 'o caminho de saída não é uma pasta: {path}',
+  NO_FILE_SELECTED: 'nenhum arquivo selecionado',
+  PDF_READ_FAILED: 'falha ao ler o PDF',
+  PAGE_COUNT_FAILED: 'falha ao obter o número de páginas',
+
+  PDF_ENCRYPTED: '{path} está protegido por senha',
+  INCORRECT_PASSWORD: 'senha incorreta para {path}',
+  PDF_PERMISSION_DENIED: '{path} não permite esta operação sem a senha do proprietário',
+  DECRYPT_REQUIRED: '{path} está protegido por senha e esta operação não pode manter a proteção; ative a descriptografia para gravar uma saída sem proteção',
+
+  NO_INPUT_FILES: 'nenhum arquivo de entrada fornecido',
+  EMPTY_INPUT_PATH: 'caminho de arquivo vazio no índice {index}',
+  INVALID_INPUT_FILE: 'arquivo de entrada',
+  INVALID_INPUT_FILE_AT: 'arquivo de entrada {index}',
+  UNREADABLE_INPUT_FILE: 'O arquivo PDF {index} ({filename}) tem problemas e não pode ser processado. Ele pode ter uma codificação de fonte inválida ou estar corrompido. Tente reparar o PDF ou use outro arquivo',
+  OUTPUT_FILENAME_EMPTY: 'o nome do arquivo de saída não pode estar vazio',
+  OUTPUT_NOT_CREATED: 'o arquivo de saída não foi criado em: {path}',
+  CREATE_OUTPUT_FAILED: 'falha ao criar o arquivo temporário para {path}',
+  WRITE_OUTPUT_FAILED: 'falha ao gravar o arquivo de saída {path}',
+  MOVE_OUTPUT_FAILED: 'falha ao mover o arquivo de saída para {path}',
+  INSPECT_OUTPUT_FAILED: 'falha ao inspecionar o arquivo de saída {path}',
+  OUTPUT_EXISTS: 'o arquivo de saída já existe: {path}',
+  INVALID_CONFLICT_POLICY: 'política de conflito inválida: {policy} (deve ser overwrite, rename, skip ou fail)',
+
+  MERGE_FONT_ENCODING: 'falha ao mesclar os PDFs devido a problemas de codificação de fonte. Um ou mais PDFs podem ter codificação de fonte inválida (por exemplo, codificação NULL). Tente reparar os PDFs com problema antes de mesclar',
+  MERGE_FAILED: 'falha ao mesclar os PDFs',
+  MERGE_PAGE_RANGE_INVALID: 'arquivo PDF {index} ({filename}): intervalo de páginas inválido {range}',
+  INVALID_BOOKMARKS: 'marcadores inválidos {value} (deve ser filename ou title)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: 'não é possível intercalar {fronts} frentes com {backs} versos; deve haver tantos versos quanto frentes, ou um a menos',
+  COLLATE_FAILED: 'falha ao intercalar os PDFs',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'divisão {index}: a página inicial {page} está fora do intervalo (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'divisão {index}: a página final {page} é inválida (deve ser >= página inicial e <= {totalPages})',
+  SPLIT_FILENAME_EMPTY: 'divisão {index}: o nome do arquivo não pode estar vazio',
+  DUPLICATE_FILENAME: 'nome de arquivo duplicado: {filename}',
+  SPLIT_FAILED: 'falha ao extrair as páginas da divisão {index} (páginas {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'as páginas por arquivo devem ser pelo menos 1, recebido {count}',
+  INVALID_FILENAME_PATTERN: 'padrão de nome de arquivo inválido {pattern}',
+  INVALID_OUTLINE_LEVEL: 'nível de estrutura inválido {level} (deve ser pelo menos 1)',
+  OUTLINE_READ_FAILED: 'falha ao ler os marcadores de {path}',
+  NO_BOOKMARKS_AT_LEVEL: 'o PDF não tem marcadores no nível de estrutura {level}',
+  SPLIT_MAX_SIZE_INVALID: 'o tamanho máximo do arquivo deve ser maior que 0, recebido {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'só a página {page} tem {size} bytes, mais do que o máximo de {maxBytes} bytes',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'rotação {index}: a página inicial {page} está fora do intervalo (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'rotação {index}: a página final {page} é inválida (deve ser >= página inicial e <= {totalPages})',
+  INVALID_ROTATION_ANGLE: 'rotação {index}: ângulo de rotação inválido {angle} (deve ser 90, -90 ou 180)',
+  ROTATE_FAILED: 'falha ao girar as páginas da rotação {index} (páginas {startPage}-{endPage}, ângulo {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'o texto da marca d\'água não pode estar vazio',
+  INVALID_FONT_SIZE: 'o tamanho da fonte deve ser pelo menos 1',
+  INVALID_OPACITY: 'a opacidade deve estar entre 0.0 e 1.0',
+  INVALID_FONT_COLOR: 'cor da fonte inválida',
+  WATERMARK_CREATE_FAILED: 'falha ao criar a marca d\'água',
+  WATERMARK_FAILED: 'falha ao aplicar a marca d\'água',
+
+  OWNER_PASSWORD_EMPTY: 'a senha do proprietário não pode estar vazia',
+  INVALID_ENCRYPTION_ALGORITHM: 'algoritmo de criptografia inválido: {algorithm} (deve ser aes128 ou aes256)',
+  ENCRYPT_FAILED: 'falha ao criptografar o PDF',
+  PDF_ALREADY_ENCRYPTED: '{path} já está criptografado',
+  PDF_NOT_ENCRYPTED: '{path} não está criptografado',
+  DECRYPT_FAILED: 'falha ao descriptografar o PDF',
+  PERMISSION_CHANGE_EMPTY: 'nenhuma nova permissão ou senha informada',
+  CHANGE_PERMISSIONS_FAILED: 'falha ao alterar as permissões',
+
+  OPTIMIZE_FAILED: 'falha ao otimizar o PDF',
+
+  EXTRACT_PAGES_FAILED: 'falha ao extrair as páginas',
+  DELETE_ALL_PAGES: 'não é possível excluir todas as {totalPages} páginas; pelo menos uma página deve permanecer',
+  DELETE_PAGES_FAILED: 'falha ao excluir as páginas',
+
+  REORDER_MODE_INVALID: 'informe exatamente uma opção: uma sequência de páginas, movimentações ou inversão',
+  REORDER_DUPLICATE_PAGE: 'a página {page} aparece mais de uma vez',
+  REORDER_MISSING_PAGES: 'faltam as páginas {pages} na sequência',
+  MOVE_POSITION_INVALID: 'movimentação {index}: posição inválida {position} (deve ser before ou after)',
+  MOVE_PAGES_INVALID: 'movimentação {index}: páginas inválidas {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'movimentação {index}: a página de destino {page} está fora do intervalo (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'movimentação {index}: a página de destino {page} não pode ser uma das páginas movidas',
+  REORDER_FAILED: 'falha ao reordenar as páginas',
+
+  INSERT_MODE_INVALID: 'informe um número de páginas em branco ou um PDF do qual inserir páginas',
+  INSERT_BLANK_COUNT_INVALID: 'número de páginas em branco inválido {count} (deve ser pelo menos 1)',
+  INSERT_POSITION_INVALID: 'posição inválida {position} (deve ser before ou after)',
+  INSERT_PAGES_FAILED: 'falha ao inserir as páginas',
+
+  INVALID_PAGE_RANGE: 'intervalo de páginas inválido',
+  PAGE_RANGE_EMPTY: 'o intervalo de páginas não pode estar vazio',
+  PAGE_RANGE_FORMAT: 'formato de intervalo de páginas inválido: {range}',
+  PAGE_RANGE_START_EMPTY: 'intervalo de páginas inválido: a página inicial está vazia',
+  INVALID_PAGE_NUMBER: 'número de página inválido {value}',
+  PAGE_OUT_OF_RANGE: 'a página {page} está fora do intervalo (1-{totalPages})',
+  END_PAGE_INVALID: 'a página final {page} é inválida (deve ser >= página inicial e <= {totalPages})',
+  NO_PAGES_SELECTED: 'nenhuma página válida no intervalo',
+  INVALID_COLOR_FORMAT: 'formato de cor hexadecimal inválido',
+
+  DUPLICATE_INPUT: '{filename} foi incluído mais de uma vez',
+  COLLATE_SAME_FILE: 'as frentes e os versos são o mesmo arquivo',
+  PAGES_NOT_IN_SPLIT: 'páginas não incluídas em nenhuma divisão: {pages}',
+  ROTATIONS_OVERLAP: 'as rotações {first} e {second} se sobrepõem; as páginas sobrepostas são giradas por ambas',
+  WATERMARK_INVISIBLE: 'a opacidade da marca d\'água é 0; a marca d\'água não ficará visível',
+  SAME_PASSWORDS: 'as senhas de usuário e de proprietário são iguais; qualquer pessoa que consiga abrir o PDF pode alterar suas permissões',
+  OPTIMIZE_NOT_SMALLER: 'o PDF otimizado não é menor que o de entrada',
+  PAGE_ORDER_UNCHANGED: 'a ordem das páginas não foi alterada',
+};
@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const ru: ErrorMessages = {
+  INTERNAL: 'внутренняя ошибка',
+  OPERATION_CANCELLED: 'операция отменена',
+  JOB_NOT_FOUND: 'задание не найдено: {id}',
+  INVALID_LANGUAGE: 'недопустимый код языка: {language}',
+
+  FILE_PATH_EMPTY: 'путь к файлу не может быть пустым',
+  FILE_NOT_FOUND: 'файл не найден: {path}',
+  FILE_ACCESS: 'ошибка доступа к файлу {path}',
+  PATH_IS_DIRECTORY: 'путь указывает на папку, а не на файл: {path}',
+  NOT_A_PDF: 'файл не является PDF: {path}',
+  OUTPUT_DIR_NOT_FOUND: 'папка вывода не существует: {path}',
+  OUTPUT_DIR_ACCESS: 'ошибка доступа к папке вывода',
+  OUTPUT_NOT_DIRECTORY: 'путь вывода не является папкой: {path}',
+  NO_FILE_SELECTED: 'файл не выбран',
+  PDF_READ_FAILED: 'не удалось прочитать PDF',
+  PAGE_COUNT_FAILED: 'не удалось получить количество страниц',
+
+  PDF_ENCRYPTED: '{path} защищён паролем',
+  INCORRECT_PASSWORD: 'неверный пароль для {path}',
+  PDF_PERMISSION_DENIED: '{path} не разрешает эту операцию без пароля владельца',
+  DECRYPT_REQUIRED: '{path} защищён паролем, и эта операция не может сохранить защиту; включите расшифровку, чтобы записать незащищённый результат',
+
+  NO_INPUT_FILES: 'входные файлы не указаны',
+  EMPTY_INPUT_PATH: 'пустой путь к файлу в позиции {index}',
+  INVALID_INPUT_FILE: 'входной файл',
+  INVALID_INPUT_FILE_AT: 'входной файл {index}',
+  UNREADABLE_INPUT_FILE: 'PDF-файл {index} ({filename}) содержит ошибки и не может быть обработан. Возможно, в нём недопустимая кодировка шрифтов или он повреждён. Попробуйте восстановить PDF или используйте другой файл',
+  OUTPUT_FILENAME_EMPTY: 'имя выходного файла не может быть пустым',
+  OUTPUT_NOT_CREATED: 'выходной файл не был создан: {path}',
+  CREATE_OUTPUT_FAILED: 'не удалось создать временный файл для {path}',
+  WRITE_OUTPUT_FAILED: 'не удалось записать выходной файл {path}',
+  MOVE_OUTPUT_FAILED: 'не удалось переместить выходной файл в {path}',
+  INSPECT_OUTPUT_FAILED: 'не удалось проверить выходной файл {path}',
+  OUTPUT_EXISTS: 'выходной файл уже существует: {path}',
+  INVALID_CONFLICT_POLICY: 'недопустимая политика конфликтов: {policy} (допустимо overwrite, rename, skip или fail)',
+
+  MERGE_FONT_ENCODING: 'не удалось объединить PDF из-за проблем с кодировкой шрифтов. Один или несколько PDF могут иметь недопустимую кодировку шрифтов (например, NULL). Попробуйте восстановить проблемные PDF перед объединением',
+  MERGE_FAILED: 'не удалось объединить PDF',
+  MERGE_PAGE_RANGE_INVALID: 'PDF-файл {index} ({filename}): недопустимый диапазон страниц {range}',
+  INVALID_BOOKMARKS: 'недопустимые закладки {value} (допустимо filename или title)',
+
+  COLLATE_PAGE_COUNT_MISMATCH: 'невозможно чередовать {fronts} лицевых страниц с {backs} оборотными; оборотных должно быть столько же, сколько лицевых, или на одну меньше',
+  COLLATE_FAILED: 'не удалось чередовать страницы PDF',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: 'разделение {index}: начальная страница {page} вне диапазона (1-{totalPages})',
+  SPLIT_END_PAGE_INVALID: 'разделение {index}: конечная страница {page} недопустима (должна быть >= начальной и <= {totalPages})',
+  SPLIT_FILENAME_EMPTY: 'разделение {index}: имя файла не может быть пустым',
+  DUPLICATE_FILENAME: 'повторяющееся имя файла: {filename}',
+  SPLIT_FAILED: 'не удалось извлечь страницы для разделения {index} (страницы {startPage}-{endPage})',
+  SPLIT_PAGES_PER_FILE_INVALID: 'страниц на файл должно быть не меньше 1, получено {count}',
+  INVALID_FILENAME_PATTERN: 'недопустимый шаблон имени файла {pattern}',
+  INVALID_OUTLINE_LEVEL: 'недопустимый уровень структуры {level} (должен быть не меньше 1)',
+  OUTLINE_READ_FAILED: 'не удалось прочитать закладки {path}',
+  NO_BOOKMARKS_AT_LEVEL: 'в PDF нет закладок на уровне структуры {level}',
+  SPLIT_MAX_SIZE_INVALID: 'максимальный размер файла должен быть больше 0, получено {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: 'одна страница {page} занимает {size} байт, больше максимума в {maxBytes} байт',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: 'поворот {index}: начальная страница {page} вне диапазона (1-{totalPages})',
+  ROTATION_END_PAGE_INVALID: 'поворот {index}: конечная страница {page} недопустима (должна быть >= начальной и <= {totalPages})',
+  INVALID_ROTATION_ANGLE: 'поворот {index}: недопустимый угол поворота {angle} (допустимо 90, -90 или 180)',
+  ROTATE_FAILED: 'не удалось повернуть страницы для поворота {index} (страницы {startPage}-{endPage}, угол {angle})',
+
+  WATERMARK_TEXT_EMPTY: 'текст водяного знака не может быть пустым',
+  INVALID_FONT_SIZE: 'размер шрифта должен быть не меньше 1',
+  INVALID_OPACITY: 'непрозрачность должна быть от 0.0 до 1.0',
+  INVALID_FONT_COLOR: 'недопустимый цвет шрифта',
+  WATERMARK_CREATE_FAILED: 'не удалось создать водяной знак',
+  WATERMARK_FAILED: 'не удалось добавить водяной знак',
+
+  OWNER_PASSWORD_EMPTY: 'пароль владельца не может быть пустым',
+  INVALID_ENCRYPTION_ALGORITHM: 'недопустимый алгоритм шифрования: {algorithm} (допустимо aes128 или aes256)',
+  ENCRYPT_FAILED: 'не удалось зашифровать PDF',
+  PDF_ALREADY_ENCRYPTED: '{path} уже зашифрован',
+  PDF_NOT_ENCRYPTED: '{path} не зашифрован',
+  DECRYPT_FAILED: 'не удалось расшифровать PDF',
+  PERMISSION_CHANGE_EMPTY: 'новые разрешения или пароли не указаны',
+  CHANGE_PERMISSIONS_FAILED: 'не удалось изменить разрешения',
+
+  OPTIMIZE_FAILED: 'не удалось оптимизировать PDF',
+
+  EXTRACT_PAGES_FAILED: 'не удалось извлечь страницы',
+  DELETE_ALL_PAGES: 'нельзя удалить все {totalPages} страниц; должна остаться хотя бы одна страница',
+  DELETE_PAGES_FAILED: 'не удалось удалить страницы',
+
+  REORDER_MODE_INVALID: 'укажите ровно одно: последовательность страниц, перемещения или обратный порядок',
+  REORDER_DUPLICATE_PAGE: 'страница {page} указана более одного раза',
+  REORDER_MISSING_PAGES: 'в последовательности отсутствуют страницы {pages}',
+  MOVE_POSITION_INVALID: 'перемещение {index}: недопустимая позиция {position} (допустимо before или after)',
+  MOVE_PAGES_INVALID: 'перемещение {index}: недопустимые страницы {range}',
+  MOVE_TARGET_OUT_OF_RANGE: 'перемещение {index}: целевая страница {page} вне диапазона (1-{totalPages})',
+  MOVE_TARGET_MOVED: 'перемещение {index}: целевая страница {page} не может быть среди перемещаемых',
+  REORDER_FAILED: 'не удалось изменить порядок страниц',
+
+  INSERT_MODE_INVALID: 'укажите либо количество пустых страниц, либо PDF, из которого вставить страницы',
+  INSERT_BLANK_COUNT_INVALID: 'недопустимое количество пустых страниц {count} (должно быть не меньше 1)',
+  INSERT_POSITION_INVALID: 'недопустимая позиция {position} (допустимо before или after)',
+  INSERT_PAGES_FAILED: 'не удалось вставить страницы',
+
+  INVALID_PAGE_RANGE: 'недопустимый диапазон страниц',
+  PAGE_RANGE_EMPTY: 'диапазон страниц не может быть пустым',
+  PAGE_RANGE_FORMAT: 'недопустимый формат диапазона страниц: {range}',
+  PAGE_RANGE_START_EMPTY: 'недопустимый диапазон страниц: начальная страница не указана',
+  INVALID_PAGE_NUMBER: 'недопустимый номер страницы {value}',
+  PAGE_OUT_OF_RANGE: 'страница {page} вне диапазона (1-{totalPages})',
+  END_PAGE_INVALID: 'конечная страница {page} недопустима (должна быть >= начальной и <= {totalPages})',
+  NO_PAGES_SELECTED: 'в диапазоне нет допустимых страниц',
+  INVALID_COLOR_FORMAT: 'недопустимый шестнадцатеричный формат цвета',
+
+  DUPLICATE_INPUT: '{filename} включён более одного раза',
+  COLLATE_SAME_FILE: 'лицевые и оборотные стороны — один и тот же файл',
+  PAGES_NOT_IN_SPLIT: 'страницы, не вошедшие ни в одну часть: {pages}',
+  ROTATIONS_OVERLAP: 'повороты {first} и {second} пересекаются; общие страницы поворачиваются обоими',
+  WATERMARK_INVISIBLE: 'непрозрачность водяного знака равна 0; водяной знак не будет виден',
+  SAME_PASSWORDS: 'пароли пользователя и владельца совпадают; любой, кто может открыть PDF, может изменить его разрешения',
+  OPTIMIZE_NOT_SMALLER: 'оптимизированный PDF не меньше исходного',
+  PAGE_ORDER_UNCHANGED: 'порядок страниц не изменился',
+};
@@ -0,0 +1,4 @@
+// Message templates keyed by backend error code (see services/errors.go) or
+// warning code (see services/result.go).
+// {name} placeholders are replaced with the error's or warning's params.
+export type ErrorMessages = Partial<Record<string, string>>;
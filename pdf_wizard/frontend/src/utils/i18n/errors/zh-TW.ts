@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const zhTW: ErrorMessages = {
+  INTERNAL: '內部錯誤',
+  OPERATION_CANCELLED: '操作已取消',
+  JOB_NOT_FOUND: '找不到工作：{id}',
+  INVALID_LANGUAGE: '無效的語言代碼：{language}',
+
+  FILE_PATH_EMPTY: '檔案路徑不能為空',
+  FILE_NOT_FOUND: '找不到檔案：{path}',
+  FILE_ACCESS: '存取檔案 {path} 時發生錯誤',
+  PATH_IS_DIRECTORY: '路徑是資料夾而不是檔案：{path}',
+  NOT_A_PDF: '檔案不是 PDF：{path}',
+  OUTPUT_DIR_NOT_FOUND: '輸出資料夾不存在：{path}',
+  OUTPUT_DIR_ACCESS: '存取輸出資料夾時發生錯誤',
+  OUTPUT_NOT_DIRECTORY: '輸出路徑不是資料夾：{path}',
+  NO_FILE_SELECTED: '未選擇檔案',
+  PDF_READ_FAILED: '讀取 PDF 失敗',
+  PAGE_COUNT_FAILED: '取得頁數失敗',
+
+  PDF_ENCRYPTED: '{path} 受密碼保護',
+  INCORRECT_PASSWORD: '{path} 的密碼不正確',
+  PDF_PERMISSION_DENIED: '沒有擁有者密碼時，{path} 不允許此操作',
+  DECRYPT_REQUIRED: '{path} 受密碼保護，此操作無法保留保護；請啟用解密以寫入不受保護的輸出',
+
+  NO_INPUT_FILES: '未提供輸入檔案',
+  EMPTY_INPUT_PATH: '索引 {index} 處的檔案路徑為空',
+  INVALID_INPUT_FILE: '輸入檔案',
+  INVALID_INPUT_FILE_AT: '輸入檔案 {index}',
+  UNREADABLE_INPUT_FILE: 'PDF 檔案 {index}（{filename}）有問題，無法處理。該檔案可能包含無效的字型編碼或已損毀。請嘗試修復該 PDF 或使用其他檔案',
+  OUTPUT_FILENAME_EMPTY: '輸出檔名不能為空',
+  OUTPUT_NOT_CREATED: '未在以下位置建立輸出檔案：{path}',
+  CREATE_OUTPUT_FAILED: '為 {path} 建立暫存檔失敗',
+  WRITE_OUTPUT_FAILED: '寫入輸出檔案 {path} 失敗',
+  MOVE_OUTPUT_FAILED: '將輸出檔案移動到 {path} 失敗',
+  INSPECT_OUTPUT_FAILED: '檢查輸出檔案 {path} 失敗',
+  OUTPUT_EXISTS: '輸出檔案已存在：{path}',
+  INVALID_CONFLICT_POLICY: '無效的衝突策略：{policy}（必須是 overwrite、rename、skip 或 fail）',
+
+  MERGE_FONT_ENCODING: '由於字型編碼問題，合併 PDF 失敗。一個或多個 PDF 可能包含無效的字型編碼（例如 NULL 編碼）。請先修復有問題的 PDF 再合併',
+  MERGE_FAILED: '合併 PDF 失敗',
+  MERGE_PAGE_RANGE_INVALID: 'PDF 檔案 {index}（{filename}）：無效的頁面範圍 {range}',
+  INVALID_BOOKMARKS: '無效的書籤設定 {value}（必須是 filename 或 title）',
+
+  COLLATE_PAGE_COUNT_MISMATCH: '無法將 {fronts} 頁正面與 {backs} 頁背面交錯合併；背面頁數必須與正面相同或少一頁',
+  COLLATE_FAILED: '交錯合併 PDF 失敗',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: '分割 {index}：起始頁 {page} 超出範圍（1-{totalPages}）',
+  SPLIT_END_PAGE_INVALID: '分割 {index}：結束頁 {page} 無效（必須 >= 起始頁且 <= {totalPages}）',
+  SPLIT_FILENAME_EMPTY: '分割 {index}：檔名不能為空',
+  DUPLICATE_FILENAME: '檔名重複：{filename}',
+  SPLIT_FAILED: '擷取分割 {index} 的頁面失敗（第 {startPage}-{endPage} 頁）',
+  SPLIT_PAGES_PER_FILE_INVALID: '每個檔案的頁數必須至少為 1，實際為 {count}',
+  INVALID_FILENAME_PATTERN: '無效的檔名樣式 {pattern}',
+  INVALID_OUTLINE_LEVEL: '無效的大綱層級 {level}（必須至少為 1）',
+  OUTLINE_READ_FAILED: '讀取 {path} 的書籤失敗',
+  NO_BOOKMARKS_AT_LEVEL: '此 PDF 在大綱層級 {level} 沒有書籤',
+  SPLIT_MAX_SIZE_INVALID: '檔案大小上限必須大於 0，實際為 {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: '僅第 {page} 頁就有 {size} 位元組，超過了 {maxBytes} 位元組的上限',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: '旋轉 {index}：起始頁 {page} 超出範圍（1-{totalPages}）',
+  ROTATION_END_PAGE_INVALID: '旋轉 {index}：結束頁 {page} 無效（必須 >= 起始頁且 <= {totalPages}）',
+  INVALID_ROTATION_ANGLE: '旋轉 {index}：無效的旋轉角度 {angle}（必須是 90、-90 或 180）',
+  ROTATE_FAILED: '旋轉 {index} 的頁面失敗（第 {startPage}-{endPage} 頁，角度 {angle}）',
+
+  WATERMARK_TEXT_EMPTY: '浮水印文字不能為空',
+  INVALID_FONT_SIZE: '字型大小必須至少為 1',
+  INVALID_OPACITY: '不透明度必須介於 0.0 到 1.0 之間',
+  INVALID_FONT_COLOR: '無效的字型顏色',
+  WATERMARK_CREATE_FAILED: '建立浮水印失敗',
+  WATERMARK_FAILED: '加入浮水印失敗',
+
+  OWNER_PASSWORD_EMPTY: '擁有者密碼不能為空',
+  INVALID_ENCRYPTION_ALGORITHM: '無效的加密演算法：{algorithm}（必須是 aes128 或 aes256）',
+  ENCRYPT_FAILED: '加密 PDF 失敗',
+  PDF_ALREADY_ENCRYPTED: '{path} 已加密',
+  PDF_NOT_ENCRYPTED: '{path} 未加密',
+  DECRYPT_FAILED: '解密 PDF 失敗',
+  PERMISSION_CHANGE_EMPTY: '未提供新的權限或密碼',
+  CHANGE_PERMISSIONS_FAILED: '變更權限失敗',
+
+  OPTIMIZE_FAILED: '最佳化 PDF 失敗',
+
+  EXTRACT_PAGES_FAILED: '擷取頁面失敗',
+  DELETE_ALL_PAGES: '無法刪除全部 {totalPages} 頁；至少必須保留一頁',
+  DELETE_PAGES_FAILED: '刪除頁面失敗',
+
+  REORDER_MODE_INVALID: '頁面順序、移動或反轉必須且只能指定一項',
+  REORDER_DUPLICATE_PAGE: '第 {page} 頁出現了不只一次',
+  REORDER_MISSING_PAGES: '頁面順序中缺少第 {pages} 頁',
+  MOVE_POSITION_INVALID: '移動 {index}：無效的位置 {position}（必須是 before 或 after）',
+  MOVE_PAGES_INVALID: '移動 {index}：無效的頁面 {range}',
+  MOVE_TARGET_OUT_OF_RANGE: '移動 {index}：目標頁 {page} 超出範圍（1-{totalPages}）',
+  MOVE_TARGET_MOVED: '移動 {index}：目標頁 {page} 不能是被移動的頁面之一',
+  REORDER_FAILED: '重新排列頁面失敗',
+
+  INSERT_MODE_INVALID: '請指定空白頁數量或要插入頁面的 PDF 之一',
+  INSERT_BLANK_COUNT_INVALID: '無效的空白頁數量 {count}（必須至少為 1）',
+  INSERT_POSITION_INVALID: '無效的位置 {position}（必須是 before 或 after）',
+  INSERT_PAGES_FAILED: '插入頁面失敗',
+
+  INVALID_PAGE_RANGE: '無效的頁面範圍',
+  PAGE_RANGE_EMPTY: '頁面範圍不能為空',
+  PAGE_RANGE_FORMAT: '無效的頁面範圍格式：{range}',
+  PAGE_RANGE_START_EMPTY: '無效的頁面範圍：起始頁為空',
+  INVALID_PAGE_NUMBER: '無效的頁碼 {value}',
+  PAGE_OUT_OF_RANGE: '第 {page} 頁超出範圍（1-{totalPages}）',
+  END_PAGE_INVALID: '結束頁 {page} 無效（必須 >= 起始頁且 <= {totalPages}）',
+  NO_PAGES_SELECTED: '範圍內沒有有效頁面',
+  INVALID_COLOR_FORMAT: '無效的十六進位顏色格式',
+
+  DUPLICATE_INPUT: '{filename} 被包含了多次',
+  COLLATE_SAME_FILE: '正面和背面是同一個檔案',
+  PAGES_NOT_IN_SPLIT: '未包含在任何分割中的頁面：{pages}',
+  ROTATIONS_OVERLAP: '旋轉 {first} 和 {second} 重疊；重疊的頁面將被兩者同時旋轉',
+  WATERMARK_INVISIBLE: '浮水印不透明度為 0；浮水印將不可見',
+  SAME_PASSWORDS: '使用者密碼和擁有者密碼相同；任何能開啟該 PDF 的人都可以變更其權限',
+  OPTIMIZE_NOT_SMALLER: '最佳化後的 PDF 並不比輸入檔案小',
+  PAGE_ORDER_UNCHANGED: '頁面順序未改變',
+};
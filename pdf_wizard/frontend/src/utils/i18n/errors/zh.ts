@@ -0,0 +1,120 @@
+import { ErrorMessages } from './types';
+
+export const zh: ErrorMessages = {
+  INTERNAL: '内部错误',
+  OPERATION_CANCELLED: '操作已取消',
+  JOB_NOT_FOUND: '未找到任务：{id}',
+  INVALID_LANGUAGE: '无效的语言代码：{language}',
+
+  FILE_PATH_EMPTY: '文件路径不能为空',
+  FILE_NOT_FOUND: '未找到文件：{path}',
+  FILE_ACCESS: '访问文件 {path} 时出错',
+  PATH_IS_DIRECTORY: '路径是目录而不是文件：{path}',
+  NOT_A_PDF: '文件不是 PDF：{path}',
+  OUTPUT_DIR_NOT_FOUND: '输出目录不存在：{path}',
+  OUTPUT_DIR_ACCESS: '访问输出目录时出错',
+  OUTPUT_NOT_DIRECTORY: '输出路径不是目录：{path}',
+  NO_FILE_SELECTED: '未选择文件',
+  PDF_READ_FAILED: '读取 PDF 失败',
+  PAGE_COUNT_FAILED: '获取页数失败',
+
+  PDF_ENCRYPTED: '{path} 受密码保护',
+  INCORRECT_PASSWORD: '{path} 的密码不正确',
+  PDF_PERMISSION_DENIED: '没有所有者密码时，{path} 不允许此操作',
+  DECRYPT_REQUIRED: '{path} 受密码保护，此操作无法保留保护；请启用解密以写入不受保护的输出',
+
+  NO_INPUT_FILES: '未提供输入文件',
+  EMPTY_INPUT_PATH: '索引 {index} 处的文件路径为空',
+  INVALID_INPUT_FILE: '输入文件',
+  INVALID_INPUT_FILE_AT: '输入文件 {index}',
+  UNREADABLE_INPUT_FILE: 'PDF 文件 {index}（{filename}）存在问题，无法处理。该文件可能包含无效的字体编码或已损坏。请尝试修复该 PDF 或使用其他文件',
+  OUTPUT_FILENAME_EMPTY: '输出文件名不能为空',
+  OUTPUT_NOT_CREATED: '未在以下位置创建输出文件：{path}',
+  CREATE_OUTPUT_FAILED: '为 {path} 创建临时文件失败',
+  WRITE_OUTPUT_FAILED: '写入输出文件 {path} 失败',
+  MOVE_OUTPUT_FAILED: '将输出文件移动到 {path} 失败',
+  INSPECT_OUTPUT_FAILED: '检查输出文件 {path} 失败',
+  OUTPUT_EXISTS: '输出文件已存在：{path}',
+  INVALID_CONFLICT_POLICY: '无效的冲突策略：{policy}（必须是 overwrite、rename、skip 或 fail）',
+
+  MERGE_FONT_ENCODING: '由于字体编码问题，合并 PDF 失败。一个或多个 PDF 可能包含无效的字体编码（例如 NULL 编码）。请先修复有问题的 PDF 再合并',
+  MERGE_FAILED: '合并 PDF 失败',
+  MERGE_PAGE_RANGE_INVALID: 'PDF 文件 {index}（{filename}）：无效的页面范围 {range}',
+  INVALID_BOOKMARKS: '无效的书签设置 {value}（必须是 filename 或 title）',
+
+  COLLATE_PAGE_COUNT_MISMATCH: '无法将 {fronts} 页正面与 {backs} 页背面交错合并；背面页数必须与正面相同或少一页',
+  COLLATE_FAILED: '交错合并 PDF 失败',
+
+  SPLIT_START_PAGE_OUT_OF_RANGE: '拆分 {index}：起始页 {page} 超出范围（1-{totalPages}）',
+  SPLIT_END_PAGE_INVALID: '拆分 {index}：结束页 {page} 无效（必须 >= 起始页且 <= {totalPages}）',
+  SPLIT_FILENAME_EMPTY: '拆分 {index}：文件名不能为空',
+  DUPLICATE_FILENAME: '文件名重复：{filename}',
+  SPLIT_FAILED: '提取拆分 {index} 的页面失败（第 {startPage}-{endPage} 页）',
+  SPLIT_PAGES_PER_FILE_INVALID: '每个文件的页数必须至少为 1，实际为 {count}',
+  INVALID_FILENAME_PATTERN: '无效的文件名模式 {pattern}',
+  INVALID_OUTLINE_LEVEL: '无效的大纲级别 {level}（必须至少为 1）',
+  OUTLINE_READ_FAILED: '读取 {path} 的书签失败',
+  NO_BOOKMARKS_AT_LEVEL: '该 PDF 在大纲级别 {level} 没有书签',
+  SPLIT_MAX_SIZE_INVALID: '最大文件大小必须大于 0，实际为 {maxBytes}',
+  SPLIT_PAGE_TOO_LARGE: '仅第 {page} 页就有 {size} 字节，超过了 {maxBytes} 字节的上限',
+
+  ROTATION_START_PAGE_OUT_OF_RANGE: '旋转 {index}：起始页 {page} 超出范围（1-{totalPages}）',
+  ROTATION_END_PAGE_INVALID: '旋转 {index}：结束页 {page} 无效（必须 >= 起始页且 <= {totalPages}）',
+  INVALID_ROTATION_ANGLE: '旋转 {index}：无效的旋转角度 {angle}（必须是 90、-90 或 180）',
+  ROTATE_FAILED: '旋转 {index} 的页面失败（第 {startPage}-{endPage} 页，角度 {angle}）',
+
+  WATERMARK_TEXT_EMPTY: '水印文字不能为空',
+  INVALID_FONT_SIZE: '字号必须至少为 1',
+  INVALID_OPACITY: '不透明度必须在 0.0 到 1.0 之间',
+  INVALID_FONT_COLOR: '无效的字体颜色',
+  WATERMARK_CREATE_FAILED: '创建水印失败',
+  WATERMARK_FAILED: '添加水印失败',
+
+  OWNER_PASSWORD_EMPTY: '所有者密码不能为空',
+  INVALID_ENCRYPTION_ALGORITHM: '无效的加密算法：{algorithm}（必须是 aes128 或 aes256）',
+  ENCRYPT_FAILED: '加密 PDF 失败',
+  PDF_ALREADY_ENCRYPTED: '{path} 已加密',
+  PDF_NOT_ENCRYPTED: '{path} 未加密',
+  DECRYPT_FAILED: '解密 PDF 失败',
+  PERMISSION_CHANGE_EMPTY: '未提供新的权限或密码',
+  CHANGE_PERMISSIONS_FAILED: '更改权限失败',
+
+  OPTIMIZE_FAILED: '优化 PDF 失败',
+
+  EXTRACT_PAGES_FAILED: '提取页面失败',
+  DELETE_ALL_PAGES: '无法删除全部 {totalPages} 页；至少必须保留一页',
+  DELETE_PAGES_FAILED: '删除页面失败',
+
+  REORDER_MODE_INVALID: '页面顺序、移动或倒序必须且只能指定一项',
+  REORDER_DUPLICATE_PAGE: '第 {page} 页出现了不止一次',
+  REORDER_MISSING_PAGES: '页面顺序中缺少第 {pages} 页',
+  MOVE_POSITION_INVALID: '移动 {index}：无效的位置 {position}（必须是 before 或 after）',
+  MOVE_PAGES_INVALID: '移动 {index}：无效的页面 {range}',
+  MOVE_TARGET_OUT_OF_RANGE: '移动 {index}：目标页 {page} 超出范围（1-{totalPages}）',
+  MOVE_TARGET_MOVED: '移动 {index}：目标页 {page} 不能是被移动的页面之一',
+  REORDER_FAILED: '重新排序页面失败',
+
+  INSERT_MODE_INVALID: '请指定空白页数量或要插入页面的 PDF 之一',
+  INSERT_BLANK_COUNT_INVALID: '无效的空白页数量 {count}（必须至少为 1）',
+  INSERT_POSITION_INVALID: '无效的位置 {position}（必须是 before 或 after）',
+  INSERT_PAGES_FAILED: '插入页面失败',
+
+  INVALID_PAGE_RANGE: '无效的页面范围',
+  PAGE_RANGE_EMPTY: '页面范围不能为空',
+  PAGE_RANGE_FORMAT: '无效的页面范围格式：{range}',
+  PAGE_RANGE_START_EMPTY: '无效的页面范围：起始页为空',
+  INVALID_PAGE_NUMBER: '无效的页码 {value}',
+  PAGE_OUT_OF_RANGE: '第 {page} 页超出范围（1-{totalPages}）',
+  END_PAGE_INVALID: '结束页 {page} 无效（必须 >= 起始页且 <= {totalPages}）',
+  NO_PAGES_SELECTED: '范围内没有有效页面',
+  INVALID_COLOR_FORMAT: '无效的十六进制颜色格式',
+
+  DUPLICATE_INPUT: '{filename} 被包含了多次',
+  COLLATE_SAME_FILE: '正面和背面是同一个文件',
+  PAGES_NOT_IN_SPLIT: '未包含在任何拆分中的页面：{pages}',
+  ROTATIONS_OVERLAP: '旋转 {first} 和 {second} 重叠；重叠的页面将被两者同时旋转',
+  WATERMARK_INVISIBLE: '水印不透明度为 0；水印将不可见',
+  SAME_PASSWORDS: '用户密码和所有者密码相同；任何能打开该 PDF 的人都可以更改其权限',
+  OPTIMIZE_NOT_SMALLER: '优化后的 PDF 并不比输入文件小',
+  PAGE_ORDER_UNCHANGED: '页面顺序未改变',
+};
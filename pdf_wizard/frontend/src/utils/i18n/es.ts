@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const es: Translations = {
   appTitle: 'Asistente PDF',
+  recoveredFiles: 'Restaurado tras una operación interrumpida:',
   mergeTab: 'Combinar PDF',
   splitTab: 'Dividir PDF',
   rotateTab: 'Rotar PDF',
@@ -9,6 +10,11 @@ export const es: Translations = {
   language: 'Idioma',
   english: 'Inglés',
   chinese: 'Chino',
+  conflictPolicy: 'Si el archivo de salida existe',
+  conflictPolicyOverwrite: 'Sobrescribir',
+  conflictPolicyRename: 'Renombrar (añadir número)',
+  conflictPolicySkip: 'Omitir',
+  conflictPolicyFail: 'Fallar',
   selectPDFFiles: 'Seleccionar archivos PDF',
   dragDropHint: 'O arrastra y suelta archivos PDF en cualquier lugar de la ventana',
   noFilesSelected: 'No se seleccionaron archivos',
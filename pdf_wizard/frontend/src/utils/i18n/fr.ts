@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const fr: Translations = {
   appTitle: 'Assistant PDF',
+  recoveredFiles: 'Restauré après une opération interrompue :',
   mergeTab: 'Fusionner PDF',
   splitTab: 'Diviser PDF',
   rotateTab: 'Tourner PDF',
@@ -9,6 +10,11 @@ export const fr: Translations = {
   language: 'Langue',
   english: 'Anglais',
   chinese: 'Chinois',
+  conflictPolicy: 'Si le fichier de sortie existe',
+  conflictPolicyOverwrite: 'Écraser',
+  conflictPolicyRename: 'Renommer (ajouter un numéro)',
+  conflictPolicySkip: 'Ignorer',
+  conflictPolicyFail: 'Échouer',
   selectPDFFiles: 'Sélectionner les fichiers PDF',
   dragDropHint: 'Ou glissez-déposez les fichiers PDF n\'importe où sur la fenêtre',
   noFilesSelected: 'Aucun fichier sélectionné',
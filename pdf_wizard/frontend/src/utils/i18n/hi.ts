@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const hi: Translations = {
   appTitle: 'PDF विज़ार्ड',
+  recoveredFiles: 'बाधित ऑपरेशन के बाद पुनर्स्थापित:',
   mergeTab: 'PDF मर्ज करें',
   splitTab: 'PDF विभाजित करें',
   rotateTab: 'PDF घुमाएं',
@@ -9,6 +10,11 @@ export const hi: Translations = {
   language: 'भाषा',
   english: 'अंग्रेजी',
   chinese: 'चीनी',
+  conflictPolicy: 'जब आउटपुट फ़ाइल मौजूद हो',
+  conflictPolicyOverwrite: 'अधिलेखित करें',
+  conflictPolicyRename: 'नाम बदलें (संख्या जोड़ें)',
+  conflictPolicySkip: 'छोड़ें',
+  conflictPolicyFail: 'विफल करें',
   selectPDFFiles: 'PDF फ़ाइलें चुनें',
   dragDropHint: 'या विंडो पर कहीं भी PDF फ़ाइलें खींचें और छोड़ें',
   noFilesSelected: 'कोई फ़ाइलें चयनित नहीं',
@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const ja: Translations = {
   appTitle: 'PDF ウィザード',
+  recoveredFiles: '中断された操作の後に復元されました:',
   mergeTab: 'PDF を結合',
   splitTab: 'PDF を分割',
   rotateTab: 'PDF を回転',
@@ -9,6 +10,11 @@ export const ja: Translations = {
   language: '言語',
   english: '英語',
   chinese: '中国語',
+  conflictPolicy: '出力ファイルが既に存在する場合',
+  conflictPolicyOverwrite: '上書き',
+  conflictPolicyRename: '名前を変更（番号を追加）',
+  conflictPolicySkip: 'スキップ',
+  conflictPolicyFail: 'エラー',
   selectPDFFiles: 'PDF ファイルを選択',
   dragDropHint: 'または、PDF ファイルをウィンドウの任意の場所にドラッグ＆ドロップ',
   noFilesSelected: 'ファイルが選択されていません',
@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const ko: Translations = {
   appTitle: 'PDF 마법사',
+  recoveredFiles: '중단된 작업 후 복원됨:',
   mergeTab: 'PDF 병합',
   splitTab: 'PDF 분할',
   rotateTab: 'PDF 회전',
@@ -9,6 +10,11 @@ export const ko: Translations = {
   language: '언어',
   english: '영어',
   chinese: '중국어',
+  conflictPolicy: '출력 파일이 이미 있을 때',
+  conflictPolicyOverwrite: '덮어쓰기',
+  conflictPolicyRename: '이름 변경(번호 추가)',
+  conflictPolicySkip: '건너뛰기',
+  conflictPolicyFail: '실패',
   selectPDFFiles: 'PDF 파일 선택',
   dragDropHint: '또는 PDF 파일을 창 어디에나 끌어다 놓으세요',
   noFilesSelected: '선택된 파일 없음',
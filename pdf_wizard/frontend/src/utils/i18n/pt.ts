@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const pt: Translations = {
   appTitle: 'Assistente PDF',
+  recoveredFiles: 'Restaurado após uma operação interrompida:',
   mergeTab: 'Mesclar PDF',
   splitTab: 'Dividir PDF',
   rotateTab: 'Rotacionar PDF',
@@ -9,6 +10,11 @@ export const pt: Translations = {
   language: 'Idioma',
   english: 'Inglês',
   chinese: 'Chinês',
+  conflictPolicy: 'Quando o arquivo de saída existir',
+  conflictPolicyOverwrite: 'Substituir',
+  conflictPolicyRename: 'Renomear (adicionar número)',
+  conflictPolicySkip: 'Ignorar',
+  conflictPolicyFail: 'Falhar',
   selectPDFFiles: 'Selecionar arquivos PDF',
   dragDropHint: 'Ou arraste e solte arquivos PDF em qualquer lugar da janela',
   noFilesSelected: 'Nenhum arquivo selecionado',
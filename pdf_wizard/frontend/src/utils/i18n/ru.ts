@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const ru: Translations = {
   appTitle: 'PDF Мастер',
+  recoveredFiles: 'Восстановлено после прерванной операции:',
   mergeTab: 'Объединить PDF',
   splitTab: 'Разделить PDF',
   rotateTab: 'Повернуть PDF',
@@ -9,6 +10,11 @@ export const ru: Translations = {
   language: 'Язык',
   english: 'Английский',
   chinese: 'Китайский',
+  conflictPolicy: 'Если выходной файл существует',
+  conflictPolicyOverwrite: 'Перезаписать',
+  conflictPolicyRename: 'Переименовать (добавить номер)',
+  conflictPolicySkip: 'Пропустить',
+  conflictPolicyFail: 'Ошибка',
   selectPDFFiles: 'Выбрать PDF файлы',
   dragDropHint: 'Или перетащите PDF файлы в любое место окна',
   noFilesSelected: 'Файлы не выбраны',
@@ -4,6 +4,7 @@ export type Language = 'en' | 'zh' | 'zh-TW' | 'ar' | 'fr' | 'ja' | 'hi' | 'es'
 export interface Translations {
   // App
   appTitle: string;
+  recoveredFiles: string;
 
   // Tabs
   mergeTab: string;
@@ -16,6 +17,11 @@ export interface Translations {
   language: string;
   english: string;
   chinese: string;
+  conflictPolicy: string;
+  conflictPolicyOverwrite: string;
+  conflictPolicyRename: string;
+  conflictPolicySkip: string;
+  conflictPolicyFail: string;
 
   // Merge Tab
   selectPDFFiles: string;
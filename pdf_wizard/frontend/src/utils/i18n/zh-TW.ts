@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const zhTW: Translations = {
   appTitle: 'PDF 魔法師',
+  recoveredFiles: '以下檔案已在中斷的操作後還原：',
   mergeTab: '合併 PDF',
   splitTab: '拆分 PDF',
   rotateTab: '旋轉 PDF',
@@ -9,6 +10,11 @@ export const zhTW: Translations = {
   language: '語言',
   english: '英語',
   chinese: '中文',
+  conflictPolicy: '輸出檔案已存在時',
+  conflictPolicyOverwrite: '覆寫',
+  conflictPolicyRename: '重新命名（加上編號）',
+  conflictPolicySkip: '略過',
+  conflictPolicyFail: '報錯',
   selectPDFFiles: '選擇 PDF 檔案',
   dragDropHint: '或將 PDF 檔案拖放到視窗任意位置',
   noFilesSelected: '未選擇檔案',
@@ -2,6 +2,7 @@ import { Translations } from './types';
 
 export const zh: Translations = {
   appTitle: 'PDF魔法师',
+  recoveredFiles: '以下文件已在中断的操作后恢复：',
   mergeTab: '合并 PDF',
   splitTab: '拆分 PDF',
   rotateTab: '旋转 PDF',
@@ -9,6 +10,11 @@ export const zh: Translations = {
   language: '语言',
   english: '英语',
   chinese: '中文',
+  conflictPolicy: '输出文件已存在时',
+  conflictPolicyOverwrite: '覆盖',
+  conflictPolicyRename: '重命名（添加编号）',
+  conflictPolicySkip: '跳过',
+  conflictPolicyFail: '报错',
   selectPDFFiles: '选择 PDF 文件',
   dragDropHint: '或将 PDF 文件拖放到窗口任意位置',
   noFilesSelected: '未选择文件',
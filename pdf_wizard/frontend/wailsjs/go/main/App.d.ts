@@ -2,21 +2,57 @@
 // This file is automatically generated. DO NOT EDIT
 import {models} from '../models';
 
-export function ApplyWatermark(arg1:string,arg2:models.WatermarkDefinition,arg3:string,arg4:string):Promise<void>;
+export function ApplyWatermark(arg1:string,arg2:models.WatermarkDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function CancelOperation(arg1:string):Promise<void>;
+
+export function ChangePermissions(arg1:string,arg2:models.PermissionChange,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function CollatePDFs(arg1:models.CollateDefinition,arg2:string,arg3:string,arg4:models.OutputOptions):Promise<models.OperationResult>;
+
+export function DecryptPDF(arg1:string,arg2:string,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function DeletePages(arg1:string,arg2:string,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
 
 export function EmitSettingsEvent():Promise<void>;
 
+export function EncryptPDF(arg1:string,arg2:models.EncryptionDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function ExtractPages(arg1:string,arg2:string,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function GenerateSplitsByOutline(arg1:string,arg2:models.SplitOutlineDefinition):Promise<Array<models.SplitDefinition>>;
+
+export function GenerateSplitsBySize(arg1:string,arg2:models.SplitSizeDefinition):Promise<Array<models.SplitDefinition>>;
+
+export function GenerateSplitsEvery(arg1:string,arg2:models.SplitEveryDefinition):Promise<Array<models.SplitDefinition>>;
+
+export function GetConflictPolicy():Promise<string>;
+
 export function GetFileMetadata(arg1:string):Promise<models.PDFMetadata>;
 
+export function GetJob(arg1:string):Promise<models.Job>;
+
 export function GetLanguage():Promise<string>;
 
 export function GetPDFMetadata(arg1:string):Promise<models.PDFMetadata>;
 
 export function GetPDFPageCount(arg1:string):Promise<number>;
 
-export function MergePDFs(arg1:Array<string>,arg2:string,arg3:string):Promise<void>;
+export function GetRecoveredFiles():Promise<Array<string>>;
+
+export function InsertPages(arg1:string,arg2:models.InsertDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function ListJobs():Promise<Array<models.Job>>;
+
+export function MergePDFPages(arg1:Array<models.MergeInput>,arg2:string,arg3:string,arg4:models.MergeOptions,arg5:models.OutputOptions):Promise<models.OperationResult>;
 
-export function RotatePDF(arg1:string,arg2:Array<models.RotateDefinition>,arg3:string,arg4:string):Promise<void>;
+export function MergePDFs(arg1:Array<string>,arg2:string,arg3:string,arg4:models.MergeOptions,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function OptimizePDF(arg1:string,arg2:string,arg3:string,arg4:models.OutputOptions):Promise<models.OperationResult>;
+
+export function ReorderPages(arg1:string,arg2:models.ReorderDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
+
+export function RotatePDF(arg1:string,arg2:Array<models.RotateDefinition>,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<models.OperationResult>;
 
 export function SelectOutputDirectory():Promise<string>;
 
@@ -24,6 +60,50 @@ export function SelectPDFFile():Promise<string>;
 
 export function SelectPDFFiles():Promise<Array<string>>;
 
+export function SetConflictPolicy(arg1:string):Promise<void>;
+
 export function SetLanguage(arg1:string):Promise<void>;
 
-export function SplitPDF(arg1:string,arg2:Array<models.SplitDefinition>,arg3:string):Promise<void>;
+export function SetPDFPassword(arg1:string,arg2:string):Promise<void>;
+
+export function SplitPDF(arg1:string,arg2:Array<models.SplitDefinition>,arg3:string,arg4:models.OutputOptions):Promise<models.OperationResult>;
+
+export function SplitPDFByOutline(arg1:string,arg2:models.SplitOutlineDefinition,arg3:string,arg4:models.OutputOptions):Promise<models.OperationResult>;
+
+export function SplitPDFBySize(arg1:string,arg2:models.SplitSizeDefinition,arg3:string,arg4:models.OutputOptions):Promise<models.OperationResult>;
+
+export function SplitPDFEvery(arg1:string,arg2:models.SplitEveryDefinition,arg3:string,arg4:models.OutputOptions):Promise<models.OperationResult>;
+
+export function SubmitCollateJob(arg1:models.CollateDefinition,arg2:string,arg3:string,arg4:models.OutputOptions):Promise<string>;
+
+export function SubmitDecryptJob(arg1:string,arg2:string,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitDeleteJob(arg1:string,arg2:string,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitEncryptJob(arg1:string,arg2:models.EncryptionDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitExtractJob(arg1:string,arg2:string,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitInsertJob(arg1:string,arg2:models.InsertDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitMergeJob(arg1:Array<string>,arg2:string,arg3:string,arg4:models.MergeOptions,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitMergePagesJob(arg1:Array<models.MergeInput>,arg2:string,arg3:string,arg4:models.MergeOptions,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitOptimizeJob(arg1:string,arg2:string,arg3:string,arg4:models.OutputOptions):Promise<string>;
+
+export function SubmitPermissionsJob(arg1:string,arg2:models.PermissionChange,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitReorderJob(arg1:string,arg2:models.ReorderDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitRotateJob(arg1:string,arg2:Array<models.RotateDefinition>,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
+
+export function SubmitSplitEveryJob(arg1:string,arg2:models.SplitEveryDefinition,arg3:string,arg4:models.OutputOptions):Promise<string>;
+
+export function SubmitSplitJob(arg1:string,arg2:Array<models.SplitDefinition>,arg3:string,arg4:models.OutputOptions):Promise<string>;
+
+export function SubmitSplitOutlineJob(arg1:string,arg2:models.SplitOutlineDefinition,arg3:string,arg4:models.OutputOptions):Promise<string>;
+
+export function SubmitSplitSizeJob(arg1:string,arg2:models.SplitSizeDefinition,arg3:string,arg4:models.OutputOptions):Promise<string>;
+
+export function SubmitWatermarkJob(arg1:string,arg2:models.WatermarkDefinition,arg3:string,arg4:string,arg5:models.OutputOptions):Promise<string>;
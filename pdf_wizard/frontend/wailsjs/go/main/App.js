@@ -2,18 +2,66 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
-export function ApplyWatermark(arg1, arg2, arg3, arg4) {
-  return window['go']['main']['App']['ApplyWatermark'](arg1, arg2, arg3, arg4);
+export function ApplyWatermark(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['ApplyWatermark'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function CancelOperation(arg1) {
+  return window['go']['main']['App']['CancelOperation'](arg1);
+}
+
+export function ChangePermissions(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['ChangePermissions'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function CollatePDFs(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['CollatePDFs'](arg1, arg2, arg3, arg4);
+}
+
+export function DecryptPDF(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['DecryptPDF'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function DeletePages(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['DeletePages'](arg1, arg2, arg3, arg4, arg5);
 }
 
 export function EmitSettingsEvent() {
   return window['go']['main']['App']['EmitSettingsEvent']();
 }
 
+export function EncryptPDF(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['EncryptPDF'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function ExtractPages(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['ExtractPages'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function GenerateSplitsByOutline(arg1, arg2) {
+  return window['go']['main']['App']['GenerateSplitsByOutline'](arg1, arg2);
+}
+
+export function GenerateSplitsBySize(arg1, arg2) {
+  return window['go']['main']['App']['GenerateSplitsBySize'](arg1, arg2);
+}
+
+export function GenerateSplitsEvery(arg1, arg2) {
+  return window['go']['main']['App']['GenerateSplitsEvery'](arg1, arg2);
+}
+
+export function GetConflictPolicy() {
+  return window['go']['main']['App']['GetConflictPolicy']();
+}
+
 export function GetFileMetadata(arg1) {
   return window['go']['main']['App']['GetFileMetadata'](arg1);
 }
 
+export function GetJob(arg1) {
+  return window['go']['main']['App']['GetJob'](arg1);
+}
+
 export function GetLanguage() {
   return window['go']['main']['App']['GetLanguage']();
 }
@@ -26,12 +74,36 @@ export function GetPDFPageCount(arg1) {
   return window['go']['main']['App']['GetPDFPageCount'](arg1);
 }
 
-export function MergePDFs(arg1, arg2, arg3) {
-  return window['go']['main']['App']['MergePDFs'](arg1, arg2, arg3);
+export function GetRecoveredFiles() {
+  return window['go']['main']['App']['GetRecoveredFiles']();
+}
+
+export function InsertPages(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['InsertPages'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function ListJobs() {
+  return window['go']['main']['App']['ListJobs']();
+}
+
+export function MergePDFPages(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['MergePDFPages'](arg1, arg2, arg3, arg4, arg5);
 }
 
-export function RotatePDF(arg1, arg2, arg3, arg4) {
-  return window['go']['main']['App']['RotatePDF'](arg1, arg2, arg3, arg4);
+export function MergePDFs(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['MergePDFs'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function OptimizePDF(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['OptimizePDF'](arg1, arg2, arg3, arg4);
+}
+
+export function ReorderPages(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['ReorderPages'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function RotatePDF(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['RotatePDF'](arg1, arg2, arg3, arg4, arg5);
 }
 
 export function SelectOutputDirectory() {
@@ -46,10 +118,98 @@ export function SelectPDFFiles() {
   return window['go']['main']['App']['SelectPDFFiles']();
 }
 
+export function SetConflictPolicy(arg1) {
+  return window['go']['main']['App']['SetConflictPolicy'](arg1);
+}
+
 export function SetLanguage(arg1) {
   return window['go']['main']['App']['SetLanguage'](arg1);
 }
 
-export function SplitPDF(arg1, arg2, arg3) {
-  return window['go']['main']['App']['SplitPDF'](arg1, arg2, arg3);
+export function SetPDFPassword(arg1, arg2) {
+  return window['go']['main']['App']['SetPDFPassword'](arg1, arg2);
+}
+
+export function SplitPDF(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SplitPDF'](arg1, arg2, arg3, arg4);
+}
+
+export function SplitPDFByOutline(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SplitPDFByOutline'](arg1, arg2, arg3, arg4);
+}
+
+export function SplitPDFBySize(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SplitPDFBySize'](arg1, arg2, arg3, arg4);
+}
+
+export function SplitPDFEvery(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SplitPDFEvery'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitCollateJob(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SubmitCollateJob'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitDecryptJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitDecryptJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitDeleteJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitDeleteJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitEncryptJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitEncryptJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitExtractJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitExtractJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitInsertJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitInsertJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitMergeJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitMergeJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitMergePagesJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitMergePagesJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitOptimizeJob(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SubmitOptimizeJob'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitPermissionsJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitPermissionsJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitReorderJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitReorderJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitRotateJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitRotateJob'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SubmitSplitEveryJob(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SubmitSplitEveryJob'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitSplitJob(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SubmitSplitJob'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitSplitOutlineJob(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SubmitSplitOutlineJob'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitSplitSizeJob(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SubmitSplitSizeJob'](arg1, arg2, arg3, arg4);
+}
+
+export function SubmitWatermarkJob(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SubmitWatermarkJob'](arg1, arg2, arg3, arg4, arg5);
 }
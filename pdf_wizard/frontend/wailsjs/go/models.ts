@@ -1,5 +1,273 @@
 export namespace models {
 	
+	export class CollateDefinition {
+	    frontsPath: string;
+	    backsPath: string;
+	    reverseBacks: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new CollateDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.frontsPath = source["frontsPath"];
+	        this.backsPath = source["backsPath"];
+	        this.reverseBacks = source["reverseBacks"];
+	    }
+	}
+	export class EncryptionDefinition {
+	    userPassword: string;
+	    ownerPassword: string;
+	    algorithm: string;
+	    permissions: PDFPermissions;
+	
+	    static createFrom(source: any = {}) {
+	        return new EncryptionDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.userPassword = source["userPassword"];
+	        this.ownerPassword = source["ownerPassword"];
+	        this.algorithm = source["algorithm"];
+	        this.permissions = this.convertValues(source["permissions"], PDFPermissions);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ErrorInfo {
+	    code: string;
+	    message: string;
+	    params?: Record<string, any>;
+	    detail?: string;
+	    cause?: ErrorInfo;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.message = source["message"];
+	        this.params = source["params"];
+	        this.detail = source["detail"];
+	        this.cause = this.convertValues(source["cause"], ErrorInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class InsertDefinition {
+	    page: number;
+	    position: string;
+	    blankPages: number;
+	    sourcePath: string;
+	    sourcePages: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new InsertDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page = source["page"];
+	        this.position = source["position"];
+	        this.blankPages = source["blankPages"];
+	        this.sourcePath = source["sourcePath"];
+	        this.sourcePages = source["sourcePages"];
+	    }
+	}
+	export class Job {
+	    id: string;
+	    operation: string;
+	    status: string;
+	    outputs: string[];
+	    error?: string;
+	    errorInfo?: ErrorInfo;
+	    result?: OperationResult;
+	    createdAt: string;
+	    startedAt?: string;
+	    finishedAt?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Job(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.operation = source["operation"];
+	        this.status = source["status"];
+	        this.outputs = source["outputs"];
+	        this.error = source["error"];
+	        this.errorInfo = this.convertValues(source["errorInfo"], ErrorInfo);
+	        this.result = this.convertValues(source["result"], OperationResult);
+	        this.createdAt = source["createdAt"];
+	        this.startedAt = source["startedAt"];
+	        this.finishedAt = source["finishedAt"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MergeInput {
+	    path: string;
+	    pages: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MergeInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.pages = source["pages"];
+	    }
+	}
+	export class MergeOptions {
+	    optimize: boolean;
+	    bookmarks: string;
+	    nestOutlines: boolean;
+	    dividerPage: boolean;
+	    duplexPadding: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new MergeOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.optimize = source["optimize"];
+	        this.bookmarks = source["bookmarks"];
+	        this.nestOutlines = source["nestOutlines"];
+	        this.dividerPage = source["dividerPage"];
+	        this.duplexPadding = source["duplexPadding"];
+	    }
+	}
+	export class OperationResult {
+	    operationId: string;
+	    operation: string;
+	    outputs: OutputFile[];
+	    elapsedMs: number;
+	    warnings: Warning[];
+	    skipped: string[];
+	    sizeBefore?: number;
+	    sizeAfter?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new OperationResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.operationId = source["operationId"];
+	        this.operation = source["operation"];
+	        this.outputs = this.convertValues(source["outputs"], OutputFile);
+	        this.elapsedMs = source["elapsedMs"];
+	        this.warnings = this.convertValues(source["warnings"], Warning);
+	        this.skipped = source["skipped"];
+	        this.sizeBefore = source["sizeBefore"];
+	        this.sizeAfter = source["sizeAfter"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class OutputFile {
+	    path: string;
+	    size: number;
+	    pageCount: number;
+	    sha256: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new OutputFile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.size = source["size"];
+	        this.pageCount = source["pageCount"];
+	        this.sha256 = source["sha256"];
+	    }
+	}
+	export class OutputOptions {
+	    conflictPolicy: string;
+	    decrypt: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new OutputOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.conflictPolicy = source["conflictPolicy"];
+	        this.decrypt = source["decrypt"];
+	    }
+	}
 	export class PDFMetadata {
 	    path: string;
 	    name: string;
@@ -7,6 +275,7 @@ export namespace models {
 	    lastModified: string;
 	    isPDF: boolean;
 	    totalPages: number;
+	    encrypted: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new PDFMetadata(source);
@@ -20,8 +289,115 @@ export namespace models {
 	        this.lastModified = source["lastModified"];
 	        this.isPDF = source["isPDF"];
 	        this.totalPages = source["totalPages"];
+	        this.encrypted = source["encrypted"];
+	    }
+	}
+	export class PDFPermissions {
+	    print: boolean;
+	    copy: boolean;
+	    modify: boolean;
+	    annotate: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PDFPermissions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.print = source["print"];
+	        this.copy = source["copy"];
+	        this.modify = source["modify"];
+	        this.annotate = source["annotate"];
 	    }
 	}
+	export class PageMove {
+	    pages: string;
+	    position: string;
+	    target: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PageMove(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pages = source["pages"];
+	        this.position = source["position"];
+	        this.target = source["target"];
+	    }
+	}
+	export class PermissionChange {
+	    userPassword: string;
+	    ownerPassword: string;
+	    permissions?: PDFPermissions;
+	    newUserPassword?: string;
+	    newOwnerPassword?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PermissionChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.userPassword = source["userPassword"];
+	        this.ownerPassword = source["ownerPassword"];
+	        this.permissions = this.convertValues(source["permissions"], PDFPermissions);
+	        this.newUserPassword = source["newUserPassword"];
+	        this.newOwnerPassword = source["newOwnerPassword"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReorderDefinition {
+	    sequence: string;
+	    moves: PageMove[];
+	    reverse: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReorderDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sequence = source["sequence"];
+	        this.moves = this.convertValues(source["moves"], PageMove);
+	        this.reverse = source["reverse"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class RotateDefinition {
 	    startPage: number;
 	    endPage: number;
@@ -54,6 +430,48 @@ export namespace models {
 	        this.filename = source["filename"];
 	    }
 	}
+	export class SplitEveryDefinition {
+	    pagesPerFile: number;
+	    filenamePattern: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SplitEveryDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pagesPerFile = source["pagesPerFile"];
+	        this.filenamePattern = source["filenamePattern"];
+	    }
+	}
+	export class SplitOutlineDefinition {
+	    level: number;
+	    filenamePattern: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SplitOutlineDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.level = source["level"];
+	        this.filenamePattern = source["filenamePattern"];
+	    }
+	}
+	export class SplitSizeDefinition {
+	    maxBytes: number;
+	    filenamePattern: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SplitSizeDefinition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.maxBytes = source["maxBytes"];
+	        this.filenamePattern = source["filenamePattern"];
+	    }
+	}
 	export class TextWatermarkConfig {
 	    text: string;
 	    fontSize: number;
@@ -78,6 +496,22 @@ export namespace models {
 	        this.fontFamily = source["fontFamily"];
 	    }
 	}
+	export class Warning {
+	    code: string;
+	    message: string;
+	    params?: Record<string, any>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Warning(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.message = source["message"];
+	        this.params = source["params"];
+	    }
+	}
 	export class WatermarkDefinition {
 	    textConfig: TextWatermarkConfig;
 	    pageRange: string;
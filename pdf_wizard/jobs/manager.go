@@ -0,0 +1,362 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"pdf_wizard/models"
+	"pdf_wizard/services"
+)
+
+// maxFinishedJobs is the number of finished jobs kept for ListJobs
+const maxFinishedJobs = 100
+
+// finishedJobRetention is how long a finished job nobody waited for is kept
+// beyond maxFinishedJobs, so a Wait right after Submit still finds it
+const finishedJobRetention = time.Minute
+
+// caseInsensitivePaths is set on platforms whose default file systems treat
+// "Report.pdf" and "report.pdf" as the same file
+var caseInsensitivePaths = runtime.GOOS == "darwin" || runtime.GOOS == "windows"
+
+// Task is the work performed by a job. ctx carries the job ID as operation ID
+// (see services.WithOperationID) and is cancelled when the job is cancelled.
+type Task func(ctx context.Context) (models.OperationResult, error)
+
+// Manager queues PDF operations and runs them on a limited number of workers.
+// Jobs start in submission order, except that a job waiting for an output file
+// lets later jobs with other outputs go ahead. Jobs writing the same output
+// file never run at the same time and run in the order they were submitted.
+// An output may also be a directory, which is shared with every file in it.
+type Manager struct {
+	mu         sync.Mutex
+	jobs       map[string]*entry
+	order      []string // Job IDs in submission order
+	queue      []*entry // Queued jobs in submission order
+	maxWorkers int
+	running    int
+	locked     map[string]bool // Output keys of running jobs
+	onUpdate   func(job models.Job)
+}
+
+// entry is the internal state of a job
+type entry struct {
+	job      models.Job
+	keys     []string // Output lock keys, see outputKeys
+	ctx      context.Context
+	cancel   context.CancelFunc
+	task     Task
+	done     chan struct{}
+	result   models.OperationResult
+	err      error
+	finished time.Time
+	waited   bool
+}
+
+// NewManager creates a job manager running at most maxWorkers jobs at once.
+// onUpdate is called after every status change and may be nil.
+func NewManager(maxWorkers int, onUpdate func(job models.Job)) *Manager {
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+	return &Manager{
+		jobs:       make(map[string]*entry),
+		maxWorkers: maxWorkers,
+		locked:     make(map[string]bool),
+		onUpdate:   onUpdate,
+	}
+}
+
+// Submit queues a job and returns its ID without waiting for it to run.
+// outputs lists the files the job writes, or their directory if the files are
+// only known once the job runs; jobs sharing an output are serialized.
+func (m *Manager) Submit(operation string, outputs []string, task Task) string {
+	ctx, cancel := context.WithCancel(context.Background())
+	id := services.NewOperationID()
+	e := &entry{
+		job: models.Job{
+			ID:        id,
+			Operation: operation,
+			Status:    models.JobStatusQueued,
+			Outputs:   append([]string{}, outputs...),
+			CreatedAt: now(),
+		},
+		keys:   outputKeys(outputs),
+		ctx:    services.WithOperationID(ctx, id),
+		cancel: cancel,
+		task:   task,
+		done:   make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.jobs[id] = e
+	m.order = append(m.order, id)
+	m.pruneLocked()
+	m.mu.Unlock()
+	// The queued update goes out before the job can be dispatched
+	m.notify(e)
+
+	m.mu.Lock()
+	m.queue = append(m.queue, e)
+	m.mu.Unlock()
+	m.dispatch()
+	return id
+}
+
+// Run queues a job and waits for it to finish, returning the task's result
+func (m *Manager) Run(operation string, outputs []string, task Task) (models.OperationResult, error) {
+	return m.Wait(m.Submit(operation, outputs, task))
+}
+
+// Wait blocks until the job finishes and returns its result
+func (m *Manager) Wait(id string) (models.OperationResult, error) {
+	m.mu.Lock()
+	e, ok := m.jobs[id]
+	m.mu.Unlock()
+	if !ok {
+		return models.OperationResult{}, services.NewError(services.ErrCodeJobNotFound, services.ErrorParams{"id": id}, nil)
+	}
+	<-e.done
+
+	m.mu.Lock()
+	e.waited = true
+	m.pruneLocked()
+	m.mu.Unlock()
+	return e.result, e.err
+}
+
+// Cancel cancels a queued or running job
+func (m *Manager) Cancel(id string) error {
+	m.mu.Lock()
+	e, ok := m.jobs[id]
+	m.mu.Unlock()
+	if !ok {
+		return services.NewError(services.ErrCodeJobNotFound, services.ErrorParams{"id": id}, nil)
+	}
+	e.cancel()
+	// A queued job is taken off the queue right away
+	m.dispatch()
+	return nil
+}
+
+// Get returns a snapshot of a job
+func (m *Manager) Get(id string) (models.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.jobs[id]
+	if !ok {
+		return models.Job{}, services.NewError(services.ErrCodeJobNotFound, services.ErrorParams{"id": id}, nil)
+	}
+	return snapshot(e), nil
+}
+
+// List returns snapshots of all known jobs in submission order
+func (m *Manager) List() []models.Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := make([]models.Job, 0, len(m.order))
+	for _, id := range m.order {
+		jobs = append(jobs, snapshot(m.jobs[id]))
+	}
+	return jobs
+}
+
+// dispatch walks the queue in submission order, drops cancelled jobs and
+// starts every job whose outputs are free while a worker is available. A job
+// waiting for an output reserves it, so later jobs writing the same file
+// cannot overtake it; it does not hold a worker while it waits.
+func (m *Manager) dispatch() {
+	var started, cancelled []*entry
+
+	m.mu.Lock()
+	reserved := make(map[string]bool)
+	queue := m.queue[:0]
+	for _, e := range m.queue {
+		switch {
+		case e.ctx.Err() != nil:
+			cancelled = append(cancelled, e)
+		case m.running < m.maxWorkers && !m.conflictsLocked(e.keys, reserved):
+			for _, key := range e.keys {
+				m.locked[key] = true
+			}
+			m.running++
+			e.job.Status = models.JobStatusRunning
+			e.job.StartedAt = now()
+			started = append(started, e)
+		default:
+			for _, key := range e.keys {
+				reserved[key] = true
+			}
+			queue = append(queue, e)
+		}
+	}
+	clear(m.queue[len(queue):])
+	m.queue = queue
+	m.mu.Unlock()
+
+	for _, e := range cancelled {
+		m.finish(e, models.OperationResult{}, services.NewError(services.ErrCodeCancelled, nil, e.ctx.Err()))
+	}
+	for _, e := range started {
+		m.notify(e)
+		go m.run(e)
+	}
+}
+
+// conflictsLocked reports whether any of keys overlaps a key locked by a
+// running job or reserved by an earlier queued job. m.mu must be held.
+func (m *Manager) conflictsLocked(keys []string, reserved map[string]bool) bool {
+	for _, key := range keys {
+		for _, taken := range []map[string]bool{m.locked, reserved} {
+			for other := range taken {
+				if overlaps(key, other) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// overlaps reports whether two lock keys are the same path or one is a
+// directory containing the other
+func overlaps(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return a == b || strings.HasPrefix(b, strings.TrimSuffix(a, string(filepath.Separator))+string(filepath.Separator))
+}
+
+// run executes a dispatched job, then frees its worker and outputs for the
+// next queued jobs
+func (m *Manager) run(e *entry) {
+	defer e.cancel()
+
+	result, err := e.task(e.ctx)
+	// Finish before releasing the outputs so the next job writing them also finishes later
+	m.finish(e, result, err)
+
+	m.mu.Lock()
+	for _, key := range e.keys {
+		delete(m.locked, key)
+	}
+	m.running--
+	m.mu.Unlock()
+	m.dispatch()
+}
+
+// finish records the job's result and wakes up waiters
+func (m *Manager) finish(e *entry, result models.OperationResult, err error) {
+	m.mu.Lock()
+	e.result = result
+	e.err = err
+	e.finished = time.Now()
+	e.job.FinishedAt = now()
+	switch {
+	case err == nil:
+		e.job.Status = models.JobStatusDone
+		e.job.Result = &e.result
+	case errors.Is(err, context.Canceled):
+		e.job.Status = models.JobStatusCancelled
+	default:
+		e.job.Status = models.JobStatusFailed
+	}
+	if err != nil {
+		e.job.Error = err.Error()
+		e.job.ErrorInfo = services.ToErrorInfo(err)
+	}
+	m.pruneLocked()
+	m.mu.Unlock()
+
+	close(e.done)
+	m.notify(e)
+}
+
+// pruneLocked drops the oldest finished jobs beyond maxFinishedJobs. A job
+// nobody has waited for is kept until finishedJobRetention has passed.
+// m.mu must be held.
+func (m *Manager) pruneLocked() {
+	finished := 0
+	for _, id := range m.order {
+		if isFinished(m.jobs[id].job.Status) {
+			finished++
+		}
+	}
+
+	kept := m.order[:0]
+	for _, id := range m.order {
+		e := m.jobs[id]
+		if finished > maxFinishedJobs && isFinished(e.job.Status) &&
+			(e.waited || time.Since(e.finished) > finishedJobRetention) {
+			delete(m.jobs, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	m.order = kept
+}
+
+// notify reports a job update to the onUpdate callback
+func (m *Manager) notify(e *entry) {
+	if m.onUpdate == nil {
+		return
+	}
+	m.mu.Lock()
+	job := snapshot(e)
+	m.mu.Unlock()
+	m.onUpdate(job)
+}
+
+// outputKeys normalizes output paths into sorted, de-duplicated lock keys.
+// Keys are lower-cased where paths differing only in case are the same file.
+func outputKeys(outputs []string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, output := range outputs {
+		key, err := filepath.Abs(output)
+		if err != nil {
+			key = filepath.Clean(output)
+		}
+		if caseInsensitivePaths {
+			key = strings.ToLower(key)
+		}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// snapshot copies a job so callers cannot mutate manager state
+func snapshot(e *entry) models.Job {
+	job := e.job
+	job.Outputs = append([]string{}, e.job.Outputs...)
+	if e.job.Result != nil {
+		result := *e.job.Result
+		job.Result = &result
+	}
+	if e.job.ErrorInfo != nil {
+		info := *e.job.ErrorInfo
+		job.ErrorInfo = &info
+	}
+	return job
+}
+
+// isFinished reports whether a status is final
+func isFinished(status string) bool {
+	return status == models.JobStatusDone || status == models.JobStatusFailed || status == models.JobStatusCancelled
+}
+
+// now returns the current time in ISO 8601 format
+func now() string {
+	return time.Now().Format(time.RFC3339)
+}
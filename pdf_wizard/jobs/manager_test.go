@@ -0,0 +1,446 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pdf_wizard/models"
+	"pdf_wizard/services"
+)
+
+// waitForStatus polls a job until it reaches status or the test times out
+func waitForStatus(t *testing.T, m *Manager, id, status string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := m.Get(id)
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if job.Status == status {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	job, _ := m.Get(id)
+	t.Fatalf("Job %s did not reach status %q, got %q", id, status, job.Status)
+}
+
+func TestManager_RunDone(t *testing.T) {
+	m := NewManager(1, nil)
+	var gotID string
+	result, err := m.Run("merge", []string{"out.pdf"}, func(ctx context.Context) (models.OperationResult, error) {
+		gotID = services.OperationIDFromContext(ctx)
+		return models.OperationResult{OperationID: gotID, Operation: "merge"}, nil
+	})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if result.OperationID != gotID {
+		t.Errorf("Expected task result to be returned, got %+v", result)
+	}
+
+	jobs := m.List()
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	job := jobs[0]
+	if job.ID != gotID {
+		t.Errorf("Expected operation ID %s to match job ID %s", gotID, job.ID)
+	}
+	if job.Status != models.JobStatusDone || job.Operation != "merge" {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+	if job.CreatedAt == "" || job.StartedAt == "" || job.FinishedAt == "" {
+		t.Errorf("Expected timestamps to be set: %+v", job)
+	}
+	if job.Result == nil || job.Result.OperationID != gotID {
+		t.Errorf("Expected job result to be set: %+v", job.Result)
+	}
+}
+
+func TestManager_RunFailed(t *testing.T) {
+	m := NewManager(1, nil)
+	taskErr := errors.New("boom")
+	id := m.Submit("split", nil, func(ctx context.Context) (models.OperationResult, error) { return models.OperationResult{}, taskErr })
+	if _, err := m.Wait(id); !errors.Is(err, taskErr) {
+		t.Fatalf("Expected task error, got %v", err)
+	}
+
+	job, err := m.Get(id)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if job.Status != models.JobStatusFailed || job.Error != "boom" {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+	if job.ErrorInfo == nil || job.ErrorInfo.Code != string(services.ErrCodeInternal) {
+		t.Errorf("Expected error info, got %+v", job.ErrorInfo)
+	}
+}
+
+func TestManager_CancelRunning(t *testing.T) {
+	m := NewManager(1, nil)
+	started := make(chan struct{})
+	id := m.Submit("rotate", nil, func(ctx context.Context) (models.OperationResult, error) {
+		close(started)
+		<-ctx.Done()
+		return models.OperationResult{}, ctx.Err()
+	})
+	<-started
+
+	if err := m.Cancel(id); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	if _, err := m.Wait(id); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	waitForStatus(t, m, id, models.JobStatusCancelled)
+}
+
+func TestManager_CancelQueued(t *testing.T) {
+	m := NewManager(1, nil)
+	release := make(chan struct{})
+	blocker := m.Submit("merge", nil, func(ctx context.Context) (models.OperationResult, error) {
+		<-release
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, blocker, models.JobStatusRunning)
+
+	ran := false
+	queued := m.Submit("merge", nil, func(ctx context.Context) (models.OperationResult, error) {
+		ran = true
+		return models.OperationResult{}, nil
+	})
+	if job, _ := m.Get(queued); job.Status != models.JobStatusQueued {
+		t.Errorf("Expected queued job, got %q", job.Status)
+	}
+	if err := m.Cancel(queued); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	waitForStatus(t, m, queued, models.JobStatusCancelled)
+
+	close(release)
+	if _, err := m.Wait(blocker); err != nil {
+		t.Errorf("Blocking job failed: %v", err)
+	}
+	if ran {
+		t.Error("Cancelled job should not run")
+	}
+}
+
+func TestManager_WorkerLimit(t *testing.T) {
+	const workers = 2
+	m := NewManager(workers, nil)
+
+	var running, peak int32
+	var ids []string
+	for i := 0; i < 6; i++ {
+		ids = append(ids, m.Submit("watermark", nil, func(ctx context.Context) (models.OperationResult, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return models.OperationResult{}, nil
+		}))
+	}
+	for _, id := range ids {
+		if _, err := m.Wait(id); err != nil {
+			t.Fatalf("Job failed: %v", err)
+		}
+	}
+	if peak > workers {
+		t.Errorf("Expected at most %d concurrent jobs, got %d", workers, peak)
+	}
+}
+
+func TestManager_SerializesSameOutput(t *testing.T) {
+	m := NewManager(4, nil)
+	output := filepath.Join(t.TempDir(), "out.pdf")
+
+	var mu sync.Mutex
+	active := 0
+	overlapped := false
+	task := func(ctx context.Context) (models.OperationResult, error) {
+		mu.Lock()
+		active++
+		if active > 1 {
+			overlapped = true
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return models.OperationResult{}, nil
+	}
+
+	var ids []string
+	for i := 0; i < 4; i++ {
+		// Relative and absolute spellings of the same path share a lock
+		path := output
+		if i%2 == 1 {
+			path = filepath.Join(filepath.Dir(output), ".", "out.pdf")
+		}
+		ids = append(ids, m.Submit("merge", []string{path}, task))
+	}
+	for _, id := range ids {
+		if _, err := m.Wait(id); err != nil {
+			t.Fatalf("Job failed: %v", err)
+		}
+	}
+	if overlapped {
+		t.Error("Jobs writing the same output ran concurrently")
+	}
+}
+
+func TestManager_SameOutputRunsInSubmissionOrder(t *testing.T) {
+	m := NewManager(4, nil)
+	output := filepath.Join(t.TempDir(), "out.pdf")
+
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var order []string
+	task := func(name string) Task {
+		return func(ctx context.Context) (models.OperationResult, error) {
+			if name == "A" {
+				<-release
+			}
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			return models.OperationResult{}, nil
+		}
+	}
+
+	var ids []string
+	for _, name := range []string{"A", "B", "C"} {
+		ids = append(ids, m.Submit("merge", []string{output}, task(name)))
+	}
+	close(release)
+	for _, id := range ids {
+		if _, err := m.Wait(id); err != nil {
+			t.Fatalf("Job failed: %v", err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Expected jobs to finish in order %v, got %v", want, order)
+	}
+}
+
+func TestManager_WaitingForOutputHoldsNoWorker(t *testing.T) {
+	m := NewManager(2, nil)
+	dir := t.TempDir()
+
+	release := make(chan struct{})
+	blocker := m.Submit("merge", []string{filepath.Join(dir, "a.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		<-release
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, blocker, models.JobStatusRunning)
+
+	// The second job waits for a.pdf; the third writes another file and takes the free worker
+	waiting := m.Submit("merge", []string{filepath.Join(dir, "a.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{}, nil
+	})
+	other := m.Submit("merge", []string{filepath.Join(dir, "b.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, other, models.JobStatusDone)
+	if job, _ := m.Get(waiting); job.Status != models.JobStatusQueued {
+		t.Errorf("Expected the job waiting for its output to stay queued, got %q", job.Status)
+	}
+
+	close(release)
+	if _, err := m.Wait(waiting); err != nil {
+		t.Errorf("Waiting job failed: %v", err)
+	}
+}
+
+func TestManager_DirectoryOutputSerializesFilesInIt(t *testing.T) {
+	m := NewManager(4, nil)
+	dir := t.TempDir()
+
+	release := make(chan struct{})
+	split := m.Submit("split", []string{dir}, func(ctx context.Context) (models.OperationResult, error) {
+		<-release
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, split, models.JobStatusRunning)
+
+	// A file in the directory waits for the split; a sibling directory does not
+	inside := m.Submit("merge", []string{filepath.Join(dir, "out.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{}, nil
+	})
+	outside := m.Submit("merge", []string{dir + "-other.pdf"}, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, outside, models.JobStatusDone)
+	if job, _ := m.Get(inside); job.Status != models.JobStatusQueued {
+		t.Errorf("Expected the job writing into the locked directory to stay queued, got %q", job.Status)
+	}
+
+	close(release)
+	if _, err := m.Wait(inside); err != nil {
+		t.Errorf("Waiting job failed: %v", err)
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	sep := string(filepath.Separator)
+	dir := filepath.Join(sep+"out", "docs")
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{dir, dir, true},
+		{dir, filepath.Join(dir, "a.pdf"), true},
+		{filepath.Join(dir, "sub", "a.pdf"), dir, true},
+		{dir, dir + "2", false},
+		{filepath.Join(dir, "a.pdf"), filepath.Join(dir, "b.pdf"), false},
+		{sep, filepath.Join(dir, "a.pdf"), true},
+	}
+	for _, tt := range tests {
+		if got := overlaps(tt.a, tt.b); got != tt.want {
+			t.Errorf("overlaps(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestOutputKeys_CaseInsensitivePaths(t *testing.T) {
+	defer func(previous bool) { caseInsensitivePaths = previous }(caseInsensitivePaths)
+	dir := t.TempDir()
+	upper, lower := filepath.Join(dir, "Report.pdf"), filepath.Join(dir, "report.pdf")
+
+	caseInsensitivePaths = true
+	if keys := outputKeys([]string{upper, lower}); len(keys) != 1 {
+		t.Errorf("Expected one key for paths differing in case, got %v", keys)
+	}
+	if !overlaps(outputKeys([]string{strings.ToUpper(dir)})[0], outputKeys([]string{lower})[0]) {
+		t.Error("Expected a directory to contain a file spelled in another case")
+	}
+
+	caseInsensitivePaths = false
+	if keys := outputKeys([]string{upper, lower}); len(keys) != 2 {
+		t.Errorf("Expected separate keys on case-sensitive file systems, got %v", keys)
+	}
+}
+
+func TestManager_CaseInsensitiveOutputsSerialize(t *testing.T) {
+	defer func(previous bool) { caseInsensitivePaths = previous }(caseInsensitivePaths)
+	caseInsensitivePaths = true
+	m := NewManager(4, nil)
+	dir := t.TempDir()
+
+	release := make(chan struct{})
+	first := m.Submit("merge", []string{filepath.Join(dir, "Report.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		<-release
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, first, models.JobStatusRunning)
+
+	second := m.Submit("merge", []string{filepath.Join(dir, "report.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{}, nil
+	})
+	other := m.Submit("merge", []string{filepath.Join(dir, "other.pdf")}, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{}, nil
+	})
+	waitForStatus(t, m, other, models.JobStatusDone)
+	if job, _ := m.Get(second); job.Status != models.JobStatusQueued {
+		t.Errorf("Expected the job writing the same file in another case to stay queued, got %q", job.Status)
+	}
+
+	close(release)
+	if _, err := m.Wait(second); err != nil {
+		t.Errorf("Waiting job failed: %v", err)
+	}
+}
+
+func TestManager_KeepsUnwaitedJobs(t *testing.T) {
+	m := NewManager(1, nil)
+	first := m.Submit("merge", nil, func(ctx context.Context) (models.OperationResult, error) {
+		return models.OperationResult{OperationID: "first"}, nil
+	})
+	waitForStatus(t, m, first, models.JobStatusDone)
+
+	// Finished jobs beyond the limit are dropped once waited for, but the
+	// first job has not been waited for yet
+	for i := 0; i < maxFinishedJobs+5; i++ {
+		if _, err := m.Run("merge", nil, func(ctx context.Context) (models.OperationResult, error) { return models.OperationResult{}, nil }); err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+	}
+	if n := len(m.List()); n != maxFinishedJobs {
+		t.Errorf("Expected %d jobs to be kept, got %d", maxFinishedJobs, n)
+	}
+	result, err := m.Wait(first)
+	if err != nil || result.OperationID != "first" {
+		t.Errorf("Expected the first job's result, got %+v, %v", result, err)
+	}
+}
+
+func TestManager_OnUpdate(t *testing.T) {
+	var mu sync.Mutex
+	var statuses []string
+	m := NewManager(1, func(job models.Job) {
+		mu.Lock()
+		statuses = append(statuses, job.Status)
+		mu.Unlock()
+	})
+
+	if _, err := m.Run("merge", nil, func(ctx context.Context) (models.OperationResult, error) { return models.OperationResult{}, nil }); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	// The final update is sent after Wait returns, so poll briefly
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		n := len(statuses)
+		mu.Unlock()
+		if n == 3 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{models.JobStatusQueued, models.JobStatusRunning, models.JobStatusDone}
+	if len(statuses) != len(expected) {
+		t.Fatalf("Expected updates %v, got %v", expected, statuses)
+	}
+	for i := range expected {
+		if statuses[i] != expected[i] {
+			t.Errorf("Update %d: expected %q, got %q", i, expected[i], statuses[i])
+		}
+	}
+}
+
+func TestManager_UnknownJob(t *testing.T) {
+	m := NewManager(1, nil)
+	if _, err := m.Get("missing"); err == nil {
+		t.Error("Expected error for unknown job in Get")
+	}
+	if err := m.Cancel("missing"); err == nil {
+		t.Error("Expected error for unknown job in Cancel")
+	}
+	if _, err := m.Wait("missing"); err == nil {
+		t.Error("Expected error for unknown job in Wait")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/options"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 	"github.com/wailsapp/wails/v2/pkg/options/mac"
+
+	"pdf_wizard/services"
 )
 
 //go:embed all:frontend/dist
@@ -57,7 +59,9 @@ func main() {
 				Message: "A modern PDF toolkit built with Wails v2\n\nAuthor: Hanxiong Shi\nVersion 1.0.0\nCopyright © 2025",
 			},
 		},
-		OnStartup: app.startup,
+		OnStartup: app.wailsStartup,
+		// Reject frontend promises with a models.ErrorInfo (code, params) so errors can be localized
+		ErrorFormatter: services.FormatError,
 		Bind: []interface{}{
 			app,
 		},
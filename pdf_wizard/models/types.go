@@ -8,6 +8,7 @@ type PDFMetadata struct {
 	LastModified string `json:"lastModified"` // ISO 8601 format
 	IsPDF        bool   `json:"isPDF"`
 	TotalPages   int    `json:"totalPages"` // Total number of pages (0 for non-PDF files or when not needed)
+	Encrypted    bool   `json:"encrypted"`  // Password protected (only set together with TotalPages)
 }
 
 // SplitDefinition represents a split configuration
@@ -17,6 +18,33 @@ type SplitDefinition struct {
 	Filename  string `json:"filename"`  // Filename without .pdf extension
 }
 
+// SplitEveryDefinition splits a PDF into consecutive chunks of a fixed page count
+type SplitEveryDefinition struct {
+	PagesPerFile    int    `json:"pagesPerFile"`    // Pages per output file; 1 writes one file per page
+	FilenamePattern string `json:"filenamePattern"` // e.g. "{name}_{n:03}"; empty for DefaultSplitFilenamePattern
+}
+
+// SplitOutlineDefinition splits a PDF at the bookmarks of one outline level
+type SplitOutlineDefinition struct {
+	Level           int    `json:"level"`           // Outline level to split at; 1 for the top-level bookmarks
+	FilenamePattern string `json:"filenamePattern"` // e.g. "{n:02} {title}"; empty for DefaultOutlineFilenamePattern
+}
+
+// SplitSizeDefinition splits a PDF into consecutive chunks below a file size
+type SplitSizeDefinition struct {
+	MaxBytes        int64  `json:"maxBytes"`        // Maximum size of each output file in bytes
+	FilenamePattern string `json:"filenamePattern"` // e.g. "{name}_{n:03}"; empty for DefaultSplitFilenamePattern
+}
+
+// Filename patterns of generated splits. Patterns may use {name} (the input
+// filename without extension), {n} (the 1-based file number), {start} and
+// {end} (its pages), and {title} (the bookmark title) for outline splits;
+// numbers take a width like {n:03}.
+const (
+	DefaultSplitFilenamePattern   = "{name}_{n:03}"
+	DefaultOutlineFilenamePattern = "{title}"
+)
+
 // RotateDefinition represents a rotation configuration for a page range
 type RotateDefinition struct {
 	StartPage int `json:"startPage"` // 1-based page number
@@ -27,7 +55,7 @@ type RotateDefinition struct {
 // WatermarkDefinition represents a watermark configuration
 type WatermarkDefinition struct {
 	TextConfig TextWatermarkConfig `json:"textConfig"`
-	PageRange  string              `json:"pageRange"` // "all" or page range string like "1,3,5-10"
+	PageRange  string              `json:"pageRange"` // "all" or page range string like "1,3,5-10,last"
 }
 
 // TextWatermarkConfig represents text watermark configuration
@@ -40,3 +68,180 @@ type TextWatermarkConfig struct {
 	Position   string  `json:"position"`  // "center", "top-left", etc.
 	FontFamily string  `json:"fontFamily"`
 }
+
+// MergeInput is an input of a merge with the pages to take from it
+type MergeInput struct {
+	Path  string `json:"path"`  // PDF file to merge
+	Pages string `json:"pages"` // Pages in merge order like "1-2,last"; empty or "all" for every page
+}
+
+// MergeOptions controls what a merge adds to the merged document
+type MergeOptions struct {
+	Optimize      bool   `json:"optimize"`      // Optimize the merged output like OptimizePDF
+	Bookmarks     string `json:"bookmarks"`     // One of the Bookmarks values; "" adds no bookmarks
+	NestOutlines  bool   `json:"nestOutlines"`  // Keep each input's outline beneath its bookmark
+	DividerPage   bool   `json:"dividerPage"`   // Insert a blank page between inputs
+	DuplexPadding bool   `json:"duplexPadding"` // Pad inputs with a blank page so each starts on an odd page
+}
+
+// Bookmarks values: the title of the top-level bookmark added per merged input
+const (
+	BookmarksNone     = ""         // No bookmarks; the inputs' outlines are dropped
+	BookmarksFilename = "filename" // The input's filename without extension
+	BookmarksTitle    = "title"    // The input's document title, or its filename if it has none
+)
+
+// CollateDefinition describes two single-sided scans to interleave
+type CollateDefinition struct {
+	FrontsPath   string `json:"frontsPath"`   // PDF with the front sides, in order
+	BacksPath    string `json:"backsPath"`    // PDF with the back sides
+	ReverseBacks bool   `json:"reverseBacks"` // The backs were scanned last page first
+}
+
+// ReorderDefinition describes a new page order. Exactly one of Sequence,
+// Moves and Reverse is used.
+type ReorderDefinition struct {
+	Sequence string     `json:"sequence"` // Full new order like "3,1,2,4-10"; every page exactly once
+	Moves    []PageMove `json:"moves"`    // Moves applied in order
+	Reverse  bool       `json:"reverse"`  // Reverse the order of all pages
+}
+
+// Positions relative to a page
+const (
+	PositionBefore = "before"
+	PositionAfter  = "after"
+)
+
+// PageMove moves pages before or after another page. Pages are identified by
+// their page number in the input, also in later moves.
+type PageMove struct {
+	Pages    string `json:"pages"`    // Page range to move, e.g. "8-9"
+	Position string `json:"position"` // "before" or "after"
+	Target   int    `json:"target"`   // 1-based page the pages are moved next to
+}
+
+// InsertDefinition describes pages to insert before or after a page. Exactly
+// one of BlankPages and SourcePath is used.
+type InsertDefinition struct {
+	Page        int    `json:"page"`        // 1-based page the new pages are inserted next to
+	Position    string `json:"position"`    // "before" or "after"
+	BlankPages  int    `json:"blankPages"`  // Number of blank pages, sized like Page
+	SourcePath  string `json:"sourcePath"`  // PDF to insert pages from
+	SourcePages string `json:"sourcePages"` // Pages of SourcePath like "1,3-5"; empty or "all" for every page
+}
+
+// Encryption algorithms supported by EncryptionDefinition
+const (
+	EncryptionAES128 = "aes128"
+	EncryptionAES256 = "aes256"
+)
+
+// EncryptionDefinition represents a password protection configuration
+type EncryptionDefinition struct {
+	UserPassword  string         `json:"userPassword"`  // Needed to open the PDF; "" opens without a password
+	OwnerPassword string         `json:"ownerPassword"` // Needed to change passwords and permissions (required)
+	Algorithm     string         `json:"algorithm"`     // "aes128" or "aes256"; "" means aes256
+	Permissions   PDFPermissions `json:"permissions"`   // What users may do without the owner password
+}
+
+// PDFPermissions represents the user access permissions of an encrypted PDF
+type PDFPermissions struct {
+	Print    bool `json:"print"`
+	Copy     bool `json:"copy"`     // Copy and extract text and graphics
+	Modify   bool `json:"modify"`   // Modify content and assemble pages
+	Annotate bool `json:"annotate"` // Add annotations and fill in forms
+}
+
+// PermissionChange represents new permissions and/or passwords for an encrypted PDF
+type PermissionChange struct {
+	UserPassword     string          `json:"userPassword"`               // Current user password ("" if the PDF opens without one)
+	OwnerPassword    string          `json:"ownerPassword"`              // Current owner password (required)
+	Permissions      *PDFPermissions `json:"permissions,omitempty"`      // New permissions; nil keeps the current ones
+	NewUserPassword  *string         `json:"newUserPassword,omitempty"`  // nil keeps the user password; "" removes it
+	NewOwnerPassword *string         `json:"newOwnerPassword,omitempty"` // nil keeps the owner password
+}
+
+// Conflict policies decide what happens when an output file already exists
+const (
+	ConflictPolicyOverwrite = "overwrite" // Replace the existing file
+	ConflictPolicyRename    = "rename"    // Write "name (2).pdf", "name (3).pdf", ... instead
+	ConflictPolicySkip      = "skip"      // Keep the existing file and skip the output
+	ConflictPolicyFail      = "fail"      // Fail the operation with an OUTPUT_EXISTS error
+)
+
+// OutputOptions controls how an operation writes its output files
+type OutputOptions struct {
+	ConflictPolicy string `json:"conflictPolicy"` // One of the ConflictPolicy values; "" means the default
+	Decrypt        bool   `json:"decrypt"`        // Write outputs of password-protected inputs without encryption
+}
+
+// ProgressEvent reports the progress of a long-running PDF operation
+type ProgressEvent struct {
+	OperationID string  `json:"operationId"` // Unique ID of the running operation
+	Operation   string  `json:"operation"`   // "merge", "collate", "split", "extract", "delete", "reorder", "insert", "rotate", "watermark", "encrypt", "decrypt", "permissions", "optimize"
+	Phase       string  `json:"phase"`       // "validating", "reading", "measuring", "processing", "writing", "done"
+	Current     int     `json:"current"`     // Items completed in the current phase
+	Total       int     `json:"total"`       // Total items in the current phase
+	Percent     float64 `json:"percent"`     // Overall progress of the operation (0-100)
+}
+
+// OutputFile describes a file written by a PDF operation
+type OutputFile struct {
+	Path      string `json:"path"`      // Full path of the written file
+	Size      int64  `json:"size"`      // File size in bytes
+	PageCount int    `json:"pageCount"` // Number of pages in the file
+	SHA256    string `json:"sha256"`    // Hex-encoded SHA-256 of the file contents
+}
+
+// OperationResult summarizes a completed PDF operation
+type OperationResult struct {
+	OperationID string       `json:"operationId"`          // Matches the operationId of the progress events
+	Operation   string       `json:"operation"`            // "merge", "collate", "split", "extract", "delete", "reorder", "insert", "rotate", "watermark", "encrypt", "decrypt", "permissions", "optimize"
+	Outputs     []OutputFile `json:"outputs"`              // Files written, in the order they were produced
+	ElapsedMs   int64        `json:"elapsedMs"`            // Wall-clock duration in milliseconds
+	Warnings    []Warning    `json:"warnings"`             // Non-fatal issues worth showing to the user
+	Skipped     []string     `json:"skipped"`              // Outputs not written because they exist (conflict policy "skip")
+	SizeBefore  int64        `json:"sizeBefore,omitempty"` // Size in bytes before optimizing (optimize, merge with optimize)
+	SizeAfter   int64        `json:"sizeAfter,omitempty"`  // Total output size in bytes (optimize, merge with optimize)
+}
+
+// Warning is a non-fatal issue of a PDF operation, localized by Code using
+// Params like ErrorInfo
+type Warning struct {
+	Code    string                 `json:"code"`             // Stable warning code, e.g. "DUPLICATE_INPUT"
+	Message string                 `json:"message"`          // English message
+	Params  map[string]interface{} `json:"params,omitempty"` // Values for the message, e.g. filename
+}
+
+// ErrorInfo is the serialized form of a service error, sent to the frontend so
+// messages can be localized by Code using Params
+type ErrorInfo struct {
+	Code    string                 `json:"code"`             // Stable error code, e.g. "SPLIT_START_PAGE_OUT_OF_RANGE"
+	Message string                 `json:"message"`          // English message including causes
+	Params  map[string]interface{} `json:"params,omitempty"` // Values for the message, e.g. page, index, path
+	Detail  string                 `json:"detail,omitempty"` // Message of a non-service cause (e.g. a pdfcpu error)
+	Cause   *ErrorInfo             `json:"cause,omitempty"`  // Nested service error, if any
+}
+
+// Job statuses reported by the job manager
+const (
+	JobStatusQueued    = "queued"
+	JobStatusRunning   = "running"
+	JobStatusDone      = "done"
+	JobStatusFailed    = "failed"
+	JobStatusCancelled = "cancelled"
+)
+
+// Job represents a queued or finished PDF operation
+type Job struct {
+	ID         string           `json:"id"`                   // Job ID (also the operation ID in progress events)
+	Operation  string           `json:"operation"`            // "merge", "collate", "split", "extract", "delete", "reorder", "insert", "rotate", "watermark", "encrypt", "decrypt", "permissions", "optimize"
+	Status     string           `json:"status"`               // "queued", "running", "done", "failed", "cancelled"
+	Outputs    []string         `json:"outputs"`              // Output files written by the job, or the output directory of generated splits
+	Error      string           `json:"error,omitempty"`      // Error message for failed jobs
+	ErrorInfo  *ErrorInfo       `json:"errorInfo,omitempty"`  // Error code and params for failed and cancelled jobs
+	Result     *OperationResult `json:"result,omitempty"`     // Set once the job is done
+	CreatedAt  string           `json:"createdAt"`            // ISO 8601 format
+	StartedAt  string           `json:"startedAt,omitempty"`  // ISO 8601 format
+	FinishedAt string           `json:"finishedAt,omitempty"` // ISO 8601 format
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+
+	"pdf_wizard/models"
+)
+
+// CollatePDFs interleaves the pages of two single-sided scans, fronts and
+// backs, into one document: front 1, back 1, front 2, back 2, ... The backs
+// are usually scanned by turning the stack over, so ReverseBacks reads them
+// last page first. The fronts may have one page more than the backs.
+func (s *PDFService) CollatePDFs(ctx context.Context, collate models.CollateDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationCollate,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 40},
+		progressPhase{PhaseProcessing, 40},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationCollate)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input files exist and are PDFs
+	inputPaths := []string{collate.FrontsPath, collate.BacksPath}
+	for _, path := range inputPaths {
+		if err := validatePDFFile(path); err != nil {
+			return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": path}, err)
+		}
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Get page counts for validation
+	pageCounts := make([]int, len(inputPaths))
+	for i, path := range inputPaths {
+		totalPages, encrypted, err := s.inspectInput(path)
+		if err != nil {
+			return models.OperationResult{}, err
+		}
+		// The collated pages form a new document that cannot keep the inputs' encryption
+		if encrypted && !options.Decrypt {
+			return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": path}, nil)
+		}
+		pageCounts[i] = totalPages
+	}
+
+	// Every front needs a back, except that a last blank back may not have been scanned
+	fronts, backs := pageCounts[0], pageCounts[1]
+	if fronts != backs && fronts != backs+1 {
+		return models.OperationResult{}, NewError(ErrCodeCollatePageCounts, ErrorParams{"fronts": fronts, "backs": backs}, nil)
+	}
+	if collate.FrontsPath == collate.BacksPath {
+		result.warn(WarnCodeCollateSameFile, nil)
+	}
+
+	// outputFilename from frontend does not include .pdf extension
+	// Always append .pdf extension
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseReading, 0, len(inputPaths))
+
+	config := model.NewDefaultConfiguration()
+	config.Cmd = model.MERGECREATE
+	config.ValidationMode = model.ValidationRelaxed
+	config.CreateBookmarks = false
+
+	ctxDest, err := s.readMergeInput(collate.FrontsPath, nil, config)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodeCollateFailed, nil, err)
+	}
+	progress.report(PhaseReading, 1, len(inputPaths))
+
+	var backPages []int
+	if collate.ReverseBacks {
+		for page := backs; page >= 1; page-- {
+			backPages = append(backPages, page)
+		}
+	}
+	ctxSource, err := s.readMergeInput(collate.BacksPath, backPages, config)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodeCollateFailed, nil, err)
+	}
+	progress.report(PhaseReading, 2, len(inputPaths))
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseProcessing, 0, 1)
+
+	if ctxDest.XRefTable.Version() < model.V20 {
+		if ctxSource.XRefTable.Version() == model.V20 {
+			return models.OperationResult{}, NewError(ErrCodeCollateFailed, nil, pdfcpu.ErrUnsupportedVersion)
+		}
+		ctxDest.EnsureVersionForWriting()
+	}
+	// Zipping weaves each back in after its front
+	if err := pdfcpu.MergeXRefTables(filepath.Base(collate.BacksPath), ctxSource, ctxDest, true, false); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeCollateFailed, nil, err)
+	}
+	progress.report(PhaseProcessing, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseWriting, 0, 1)
+
+	// Encrypted inputs are only collated with decrypt set
+	removeEncryption(ctxDest)
+	if err := writeContextFile(ctxDest, outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := result.addOutput(outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
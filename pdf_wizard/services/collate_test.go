@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+
+	"pdf_wizard/models"
+)
+
+func TestPDFService_CollatePDFs(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	frontsPDF := filepath.Join(testDir, "fronts.pdf")
+	if err := createNumberedTestPDF(frontsPDF, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	backsPDF := filepath.Join(testDir, "backs.pdf")
+	if err := createNumberedTestPDF(backsPDF, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// The last front has no back
+	tests := []struct {
+		name    string
+		reverse bool
+		want    []int
+	}{
+		{"in order", false, []int{1, 1, 2, 2, 3}},
+		{"reversed backs", true, []int{1, 2, 2, 1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collate := models.CollateDefinition{FrontsPath: frontsPDF, BacksPath: backsPDF, ReverseBacks: tt.reverse}
+			options := models.OutputOptions{ConflictPolicy: models.ConflictPolicyOverwrite}
+			result, err := service.CollatePDFs(context.Background(), collate, testDir, "collated", options)
+			if err != nil {
+				t.Fatalf("CollatePDFs failed: %v", err)
+			}
+			if result.Operation != OperationCollate || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 5 {
+				t.Fatalf("Expected one 5-page output, got %+v", result)
+			}
+			if got := pageOrder(t, filepath.Join(testDir, "collated.pdf")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected pages %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPDFService_CollatePDFs_Validation(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	frontsPDF := filepath.Join(testDir, "fronts.pdf")
+	if err := createNumberedTestPDF(frontsPDF, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	backsPDF := filepath.Join(testDir, "backs.pdf")
+	if err := createNumberedTestPDF(backsPDF, 4); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		collate models.CollateDefinition
+		code    ErrorCode
+	}{
+		{"missing backs", models.CollateDefinition{FrontsPath: frontsPDF, BacksPath: filepath.Join(testDir, "missing.pdf")}, ErrCodeInvalidInput},
+		{"more backs than fronts", models.CollateDefinition{FrontsPath: frontsPDF, BacksPath: backsPDF}, ErrCodeCollatePageCounts},
+		{"two fronts more", models.CollateDefinition{FrontsPath: backsPDF, BacksPath: frontsPDF}, ErrCodeCollatePageCounts},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CollatePDFs(context.Background(), tt.collate, testDir, "collated", models.OutputOptions{})
+			if ErrorCodeOf(err) != tt.code {
+				t.Fatalf("Expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+
+	_, err := service.CollatePDFs(context.Background(), models.CollateDefinition{FrontsPath: frontsPDF, BacksPath: backsPDF}, testDir, "collated", models.OutputOptions{})
+	if params := ToErrorInfo(err).Params; params["fronts"] != 2 || params["backs"] != 4 {
+		t.Errorf("Expected the page counts in the error params, got %v", params)
+	}
+}
+
+func TestPDFService_CollatePDFs_EncryptedInput(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	frontsPDF := filepath.Join(testDir, "fronts.pdf")
+	if err := createNumberedTestPDF(frontsPDF, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	backsPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(backsPDF, 2); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+	if err := fileService.SetPDFPassword(backsPDF, "user"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	collate := models.CollateDefinition{FrontsPath: frontsPDF, BacksPath: backsPDF}
+	if _, err := service.CollatePDFs(context.Background(), collate, testDir, "collated", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodeDecryptRequired {
+		t.Fatalf("Expected %s, got %v", ErrCodeDecryptRequired, err)
+	}
+	result, err := service.CollatePDFs(context.Background(), collate, testDir, "collated", models.OutputOptions{Decrypt: true})
+	if err != nil {
+		t.Fatalf("CollatePDFs failed: %v", err)
+	}
+	if result.Outputs[0].PageCount != 4 {
+		t.Errorf("Expected 4 pages, got %d", result.Outputs[0].PageCount)
+	}
+	if _, err := api.PageCountFile(filepath.Join(testDir, "collated.pdf")); err != nil {
+		t.Errorf("Expected the collated PDF to open without a password: %v", err)
+	}
+}
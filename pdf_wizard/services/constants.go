@@ -4,6 +4,12 @@ const (
 	// PDFExtension is the standard PDF file extension
 	PDFExtension = ".pdf"
 
+	// TempFileSuffix is appended to outputs while they are being written
+	TempFileSuffix = ".tmp"
+
+	// BackupFileSuffix is appended to existing files while a split replaces them
+	BackupFileSuffix = ".bak"
+
 	// DefaultFilePerm is the default file permission (0644 = rw-r--r--)
 	DefaultFilePerm = 0644
 
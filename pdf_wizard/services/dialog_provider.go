@@ -0,0 +1,109 @@
+package services
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrDialogUnavailable is returned when a dialog is requested but no GUI is available
+var ErrDialogUnavailable = errors.New("file dialogs are not available")
+
+// FileFilter restricts the files shown in a file dialog
+type FileFilter struct {
+	DisplayName string // e.g. "PDF files"
+	Pattern     string // e.g. "*.pdf"
+}
+
+// DialogOptions configures a native file or directory dialog
+type DialogOptions struct {
+	Title   string
+	Filters []FileFilter
+}
+
+// DialogProvider opens native file and directory dialogs.
+// A cancelled dialog returns an empty selection and a nil error.
+type DialogProvider interface {
+	OpenFileDialog(options DialogOptions) (string, error)
+	OpenMultipleFilesDialog(options DialogOptions) ([]string, error)
+	OpenDirectoryDialog(options DialogOptions) (string, error)
+}
+
+// DialogResponse is a scripted answer returned by FakeDialogProvider
+type DialogResponse struct {
+	Paths []string // Selected paths (empty for a cancelled dialog)
+	Err   error    // Error returned by the dialog
+}
+
+// FakeDialogProvider is a scriptable DialogProvider for tests and headless use.
+// Each dialog call consumes the next queued response; with an empty queue it
+// returns ErrDialogUnavailable.
+type FakeDialogProvider struct {
+	mu        sync.Mutex
+	responses []DialogResponse
+	calls     []DialogOptions
+}
+
+// QueueSelection queues a dialog response selecting the given paths
+func (p *FakeDialogProvider) QueueSelection(paths ...string) {
+	p.queue(DialogResponse{Paths: paths})
+}
+
+// QueueCancel queues a cancelled dialog response
+func (p *FakeDialogProvider) QueueCancel() {
+	p.queue(DialogResponse{})
+}
+
+// QueueError queues a dialog response that fails with err
+func (p *FakeDialogProvider) QueueError(err error) {
+	p.queue(DialogResponse{Err: err})
+}
+
+// Calls returns the options of every dialog opened so far
+func (p *FakeDialogProvider) Calls() []DialogOptions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]DialogOptions(nil), p.calls...)
+}
+
+// OpenFileDialog returns the first path of the next queued response
+func (p *FakeDialogProvider) OpenFileDialog(options DialogOptions) (string, error) {
+	response := p.next(options)
+	if response.Err != nil || len(response.Paths) == 0 {
+		return "", response.Err
+	}
+	return response.Paths[0], nil
+}
+
+// OpenMultipleFilesDialog returns all paths of the next queued response
+func (p *FakeDialogProvider) OpenMultipleFilesDialog(options DialogOptions) ([]string, error) {
+	response := p.next(options)
+	if response.Err != nil {
+		return nil, response.Err
+	}
+	return response.Paths, nil
+}
+
+// OpenDirectoryDialog returns the first path of the next queued response
+func (p *FakeDialogProvider) OpenDirectoryDialog(options DialogOptions) (string, error) {
+	return p.OpenFileDialog(options)
+}
+
+// queue appends a scripted response
+func (p *FakeDialogProvider) queue(response DialogResponse) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.responses = append(p.responses, response)
+}
+
+// next records the call and pops the next scripted response
+func (p *FakeDialogProvider) next(options DialogOptions) DialogResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, options)
+	if len(p.responses) == 0 {
+		return DialogResponse{Err: ErrDialogUnavailable}
+	}
+	response := p.responses[0]
+	p.responses = p.responses[1:]
+	return response
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"pdf_wizard/models"
+)
+
+// ErrorCode identifies a failure independently of its (English) message so
+// the frontend can localize it. Codes are stable; never rename one.
+type ErrorCode string
+
+// Error codes returned by the services
+const (
+	// Generic
+	ErrCodeInternal        ErrorCode = "INTERNAL"
+	ErrCodeCancelled       ErrorCode = "OPERATION_CANCELLED"
+	ErrCodeJobNotFound     ErrorCode = "JOB_NOT_FOUND"
+	ErrCodeInvalidLanguage ErrorCode = "INVALID_LANGUAGE"
+
+	// Files and directories
+	ErrCodeFilePathEmpty      ErrorCode = "FILE_PATH_EMPTY"
+	ErrCodeFileNotFound       ErrorCode = "FILE_NOT_FOUND"
+	ErrCodeFileAccess         ErrorCode = "FILE_ACCESS"
+	ErrCodePathIsDirectory    ErrorCode = "PATH_IS_DIRECTORY"
+	ErrCodeNotPDF             ErrorCode = "NOT_A_PDF"
+	ErrCodeOutputDirNotFound  ErrorCode = "OUTPUT_DIR_NOT_FOUND"
+	ErrCodeOutputDirAccess    ErrorCode = "OUTPUT_DIR_ACCESS"
+	ErrCodeOutputNotDirectory ErrorCode = "OUTPUT_NOT_DIRECTORY"
+	ErrCodeNoFileSelected     ErrorCode = "NO_FILE_SELECTED"
+	ErrCodePDFRead            ErrorCode = "PDF_READ_FAILED"
+	ErrCodePageCount          ErrorCode = "PAGE_COUNT_FAILED"
+
+	// Password-protected inputs
+	ErrCodePDFEncrypted      ErrorCode = "PDF_ENCRYPTED"
+	ErrCodeIncorrectPassword ErrorCode = "INCORRECT_PASSWORD"
+	ErrCodePermissionDenied  ErrorCode = "PDF_PERMISSION_DENIED"
+	ErrCodeDecryptRequired   ErrorCode = "DECRYPT_REQUIRED"
+
+	// Inputs and outputs of operations
+	ErrCodeNoInputFiles        ErrorCode = "NO_INPUT_FILES"
+	ErrCodeEmptyInputPath      ErrorCode = "EMPTY_INPUT_PATH"
+	ErrCodeInvalidInput        ErrorCode = "INVALID_INPUT_FILE"
+	ErrCodeInvalidInputAt      ErrorCode = "INVALID_INPUT_FILE_AT"
+	ErrCodeUnreadableInput     ErrorCode = "UNREADABLE_INPUT_FILE"
+	ErrCodeOutputFilenameEmpty ErrorCode = "OUTPUT_FILENAME_EMPTY"
+	ErrCodeOutputNotCreated    ErrorCode = "OUTPUT_NOT_CREATED"
+	ErrCodeCreateOutput        ErrorCode = "CREATE_OUTPUT_FAILED"
+	ErrCodeWriteOutput         ErrorCode = "WRITE_OUTPUT_FAILED"
+	ErrCodeMoveOutput          ErrorCode = "MOVE_OUTPUT_FAILED"
+	ErrCodeInspectOutput       ErrorCode = "INSPECT_OUTPUT_FAILED"
+	ErrCodeOutputExists        ErrorCode = "OUTPUT_EXISTS"
+	ErrCodeConflictPolicy      ErrorCode = "INVALID_CONFLICT_POLICY"
+
+	// Merge
+	ErrCodeMergeFontEncoding ErrorCode = "MERGE_FONT_ENCODING"
+	ErrCodeMergeFailed       ErrorCode = "MERGE_FAILED"
+	ErrCodeMergePageRange    ErrorCode = "MERGE_PAGE_RANGE_INVALID"
+	ErrCodeBookmarksInvalid  ErrorCode = "INVALID_BOOKMARKS"
+
+	// Collate
+	ErrCodeCollatePageCounts ErrorCode = "COLLATE_PAGE_COUNT_MISMATCH"
+	ErrCodeCollateFailed     ErrorCode = "COLLATE_FAILED"
+
+	// Split
+	ErrCodeSplitStartPage     ErrorCode = "SPLIT_START_PAGE_OUT_OF_RANGE"
+	ErrCodeSplitEndPage       ErrorCode = "SPLIT_END_PAGE_INVALID"
+	ErrCodeSplitFilenameEmpty ErrorCode = "SPLIT_FILENAME_EMPTY"
+	ErrCodeDuplicateFilename  ErrorCode = "DUPLICATE_FILENAME"
+	ErrCodeSplitFailed        ErrorCode = "SPLIT_FAILED"
+	ErrCodeSplitPagesPerFile  ErrorCode = "SPLIT_PAGES_PER_FILE_INVALID"
+	ErrCodeFilenamePattern    ErrorCode = "INVALID_FILENAME_PATTERN"
+	ErrCodeOutlineLevel       ErrorCode = "INVALID_OUTLINE_LEVEL"
+	ErrCodeOutlineRead        ErrorCode = "OUTLINE_READ_FAILED"
+	ErrCodeNoBookmarks        ErrorCode = "NO_BOOKMARKS_AT_LEVEL"
+	ErrCodeSplitMaxSize       ErrorCode = "SPLIT_MAX_SIZE_INVALID"
+	ErrCodeSplitPageTooLarge  ErrorCode = "SPLIT_PAGE_TOO_LARGE"
+
+	// Rotate
+	ErrCodeRotationStartPage ErrorCode = "ROTATION_START_PAGE_OUT_OF_RANGE"
+	ErrCodeRotationEndPage   ErrorCode = "ROTATION_END_PAGE_INVALID"
+	ErrCodeRotationAngle     ErrorCode = "INVALID_ROTATION_ANGLE"
+	ErrCodeRotateFailed      ErrorCode = "ROTATE_FAILED"
+
+	// Watermark
+	ErrCodeWatermarkTextEmpty ErrorCode = "WATERMARK_TEXT_EMPTY"
+	ErrCodeFontSize           ErrorCode = "INVALID_FONT_SIZE"
+	ErrCodeOpacity            ErrorCode = "INVALID_OPACITY"
+	ErrCodeFontColor          ErrorCode = "INVALID_FONT_COLOR"
+	ErrCodeWatermarkCreate    ErrorCode = "WATERMARK_CREATE_FAILED"
+	ErrCodeWatermarkFailed    ErrorCode = "WATERMARK_FAILED"
+
+	// Encryption
+	ErrCodeOwnerPasswordEmpty  ErrorCode = "OWNER_PASSWORD_EMPTY"
+	ErrCodeEncryptionAlgorithm ErrorCode = "INVALID_ENCRYPTION_ALGORITHM"
+	ErrCodeEncryptFailed       ErrorCode = "ENCRYPT_FAILED"
+	ErrCodeAlreadyEncrypted    ErrorCode = "PDF_ALREADY_ENCRYPTED"
+	ErrCodeNotEncrypted        ErrorCode = "PDF_NOT_ENCRYPTED"
+	ErrCodeDecryptFailed       ErrorCode = "DECRYPT_FAILED"
+	ErrCodeNoPermissionChange  ErrorCode = "PERMISSION_CHANGE_EMPTY"
+	ErrCodePermissionsFailed   ErrorCode = "CHANGE_PERMISSIONS_FAILED"
+
+	// Optimization
+	ErrCodeOptimizeFailed ErrorCode = "OPTIMIZE_FAILED"
+
+	// Page operations
+	ErrCodeExtractFailed     ErrorCode = "EXTRACT_PAGES_FAILED"
+	ErrCodeDeleteAllPages    ErrorCode = "DELETE_ALL_PAGES"
+	ErrCodeDeletePagesFailed ErrorCode = "DELETE_PAGES_FAILED"
+
+	// Reordering
+	ErrCodeReorderMode          ErrorCode = "REORDER_MODE_INVALID"
+	ErrCodeReorderDuplicatePage ErrorCode = "REORDER_DUPLICATE_PAGE"
+	ErrCodeReorderMissingPages  ErrorCode = "REORDER_MISSING_PAGES"
+	ErrCodeMovePosition         ErrorCode = "MOVE_POSITION_INVALID"
+	ErrCodeMovePages            ErrorCode = "MOVE_PAGES_INVALID"
+	ErrCodeMoveTarget           ErrorCode = "MOVE_TARGET_OUT_OF_RANGE"
+	ErrCodeMoveTargetMoved      ErrorCode = "MOVE_TARGET_MOVED"
+	ErrCodeReorderFailed        ErrorCode = "REORDER_FAILED"
+
+	// Inserting
+	ErrCodeInsertMode       ErrorCode = "INSERT_MODE_INVALID"
+	ErrCodeInsertBlankCount ErrorCode = "INSERT_BLANK_COUNT_INVALID"
+	ErrCodeInsertPosition   ErrorCode = "INSERT_POSITION_INVALID"
+	ErrCodeInsertFailed     ErrorCode = "INSERT_PAGES_FAILED"
+
+	// Page ranges ("1,3,5-10,last")
+	ErrCodeInvalidPageRange  ErrorCode = "INVALID_PAGE_RANGE"
+	ErrCodePageRangeEmpty    ErrorCode = "PAGE_RANGE_EMPTY"
+	ErrCodePageRangeFormat   ErrorCode = "PAGE_RANGE_FORMAT"
+	ErrCodePageRangeNoStart  ErrorCode = "PAGE_RANGE_START_EMPTY"
+	ErrCodeInvalidPageNumber ErrorCode = "INVALID_PAGE_NUMBER"
+	ErrCodePageOutOfRange    ErrorCode = "PAGE_OUT_OF_RANGE"
+	ErrCodeEndPageInvalid    ErrorCode = "END_PAGE_INVALID"
+	ErrCodeNoPagesSelected   ErrorCode = "NO_PAGES_SELECTED"
+	ErrCodeInvalidColor      ErrorCode = "INVALID_COLOR_FORMAT"
+)
+
+// errorMessages are the English message templates for each code.
+// {name} placeholders are replaced with the error's params.
+var errorMessages = map[ErrorCode]string{
+	ErrCodeInternal:        "internal error",
+	ErrCodeCancelled:       "operation cancelled",
+	ErrCodeJobNotFound:     "job not found: {id}",
+	ErrCodeInvalidLanguage: "invalid language code: {language}",
+
+	ErrCodeFilePathEmpty:      "file path cannot be empty",
+	ErrCodeFileNotFound:       "file not found: {path}",
+	ErrCodeFileAccess:         "error accessing file {path}",
+	ErrCodePathIsDirectory:    "path is a directory, not a file: {path}",
+	ErrCodeNotPDF:             "file is not a PDF: {path}",
+	ErrCodeOutputDirNotFound:  "output directory does not exist: {path}",
+	ErrCodeOutputDirAccess:    "error accessing output directory",
+	ErrCodeOutputNotDirectory: "output path is not a directory: {path}",
+	ErrCodeNoFileSelected:     "no file selected",
+	ErrCodePDFRead:            "failed to read PDF",
+	ErrCodePageCount:          "failed to get page count",
+
+	ErrCodePDFEncrypted:      "{path} is password protected",
+	ErrCodeIncorrectPassword: "incorrect password for {path}",
+	ErrCodePermissionDenied:  "{path} does not permit this operation without the owner password",
+	ErrCodeDecryptRequired:   "{path} is password protected and this operation cannot keep the protection; enable decrypt to write unprotected output",
+
+	ErrCodeNoInputFiles:        "no input files provided",
+	ErrCodeEmptyInputPath:      "empty file path at index {index}",
+	ErrCodeInvalidInput:        "input file",
+	ErrCodeInvalidInputAt:      "input file {index}",
+	ErrCodeUnreadableInput:     "PDF file {index} ({filename}) has issues and cannot be processed. This file may have invalid font encoding or be corrupted. Please try repairing the PDF or use a different file",
+	ErrCodeOutputFilenameEmpty: "output filename cannot be empty",
+	ErrCodeOutputNotCreated:    "output file was not created at: {path}",
+	ErrCodeCreateOutput:        "failed to create temporary file for {path}",
+	ErrCodeWriteOutput:         "failed to write output file {path}",
+	ErrCodeMoveOutput:          "failed to move output file to {path}",
+	ErrCodeInspectOutput:       "failed to inspect output file {path}",
+	ErrCodeOutputExists:        "output file already exists: {path}",
+	ErrCodeConflictPolicy:      "invalid conflict policy: {policy} (must be overwrite, rename, skip or fail)",
+
+	ErrCodeMergeFontEncoding: "failed to merge PDFs due to font encoding issues. One or more PDFs may have invalid font encoding (e.g., NULL encoding). Please try repairing the problematic PDF(s) before merging",
+	ErrCodeMergeFailed:       "failed to merge PDFs",
+	ErrCodeMergePageRange:    "PDF file {index} ({filename}): invalid page range {range}",
+	ErrCodeBookmarksInvalid:  "invalid bookmarks {value} (must be filename or title)",
+
+	ErrCodeCollatePageCounts: "cannot collate {fronts} fronts with {backs} backs; there must be as many backs as fronts, or one less",
+	ErrCodeCollateFailed:     "failed to collate PDFs",
+
+	ErrCodeSplitStartPage:     "split {index}: start page {page} is out of range (1-{totalPages})",
+	ErrCodeSplitEndPage:       "split {index}: end page {page} is invalid (must be >= start page and <= {totalPages})",
+	ErrCodeSplitFilenameEmpty: "split {index}: filename cannot be empty",
+	ErrCodeDuplicateFilename:  "duplicate filename: {filename}",
+	ErrCodeSplitFailed:        "failed to trim pages for split {index} (pages {startPage}-{endPage})",
+	ErrCodeSplitPagesPerFile:  "pages per file must be at least 1, got {count}",
+	ErrCodeFilenamePattern:    "invalid filename pattern {pattern}",
+	ErrCodeOutlineLevel:       "invalid outline level {level} (must be at least 1)",
+	ErrCodeOutlineRead:        "failed to read the bookmarks of {path}",
+	ErrCodeNoBookmarks:        "the PDF has no bookmarks at outline level {level}",
+	ErrCodeSplitMaxSize:       "maximum file size must be greater than 0, got {maxBytes}",
+	ErrCodeSplitPageTooLarge:  "page {page} alone is {size} bytes, more than the maximum of {maxBytes} bytes",
+
+	ErrCodeRotationStartPage: "rotation {index}: start page {page} is out of range (1-{totalPages})",
+	ErrCodeRotationEndPage:   "rotation {index}: end page {page} is invalid (must be >= start page and <= {totalPages})",
+	ErrCodeRotationAngle:     "rotation {index}: invalid rotation angle {angle} (must be 90, -90, or 180)",
+	ErrCodeRotateFailed:      "failed to rotate pages for rotation {index} (pages {startPage}-{endPage}, angle {angle})",
+
+	ErrCodeWatermarkTextEmpty: "watermark text cannot be empty",
+	ErrCodeFontSize:           "font size must be at least 1",
+	ErrCodeOpacity:            "opacity must be between 0.0 and 1.0",
+	ErrCodeFontColor:          "invalid font color",
+	ErrCodeWatermarkCreate:    "failed to create watermark",
+	ErrCodeWatermarkFailed:    "failed to apply watermark",
+
+	ErrCodeOwnerPasswordEmpty:  "owner password cannot be empty",
+	ErrCodeEncryptionAlgorithm: "invalid encryption algorithm: {algorithm} (must be aes128 or aes256)",
+	ErrCodeEncryptFailed:       "failed to encrypt PDF",
+	ErrCodeAlreadyEncrypted:    "{path} is already encrypted",
+	ErrCodeNotEncrypted:        "{path} is not encrypted",
+	ErrCodeDecryptFailed:       "failed to decrypt PDF",
+	ErrCodeNoPermissionChange:  "no new permissions or passwords given",
+	ErrCodePermissionsFailed:   "failed to change permissions",
+
+	ErrCodeOptimizeFailed: "failed to optimize PDF",
+
+	ErrCodeExtractFailed:     "failed to extract pages",
+	ErrCodeDeleteAllPages:    "cannot delete all {totalPages} pages; at least one page must remain",
+	ErrCodeDeletePagesFailed: "failed to delete pages",
+
+	ErrCodeReorderMode:          "give exactly one of a page sequence, moves or reverse",
+	ErrCodeReorderDuplicatePage: "page {page} is listed more than once",
+	ErrCodeReorderMissingPages:  "the page sequence is missing pages {pages}",
+	ErrCodeMovePosition:         "move {index}: invalid position {position} (must be before or after)",
+	ErrCodeMovePages:            "move {index}: invalid pages {range}",
+	ErrCodeMoveTarget:           "move {index}: target page {page} is out of range (1-{totalPages})",
+	ErrCodeMoveTargetMoved:      "move {index}: target page {page} cannot be one of the moved pages",
+	ErrCodeReorderFailed:        "failed to reorder pages",
+
+	ErrCodeInsertMode:       "give either a number of blank pages or a PDF to insert pages from",
+	ErrCodeInsertBlankCount: "invalid number of blank pages {count} (must be at least 1)",
+	ErrCodeInsertPosition:   "invalid position {position} (must be before or after)",
+	ErrCodeInsertFailed:     "failed to insert pages",
+
+	ErrCodeInvalidPageRange:  "invalid page range",
+	ErrCodePageRangeEmpty:    "page range cannot be empty",
+	ErrCodePageRangeFormat:   "invalid page range format: {range}",
+	ErrCodePageRangeNoStart:  "invalid page range: start page is empty",
+	ErrCodeInvalidPageNumber: "invalid page number {value}",
+	ErrCodePageOutOfRange:    "page {page} is out of range (1-{totalPages})",
+	ErrCodeEndPageInvalid:    "end page {page} is invalid (must be >= start page and <= {totalPages})",
+	ErrCodeNoPagesSelected:   "no valid pages in range",
+	ErrCodeInvalidColor:      "invalid hex color format",
+}
+
+// ErrorParams are the values substituted into an error message, e.g. page numbers or paths
+type ErrorParams map[string]interface{}
+
+// Error is a service error with a stable code, message parameters and an optional cause
+type Error struct {
+	Code   ErrorCode
+	Params ErrorParams
+	Err    error // Wrapped cause, may be nil
+}
+
+// NewError creates a service error. params and cause may be nil.
+func NewError(code ErrorCode, params ErrorParams, cause error) *Error {
+	return &Error{Code: code, Params: params, Err: cause}
+}
+
+// Message returns the English message without the cause
+func (e *Error) Message() string {
+	template, ok := errorMessages[e.Code]
+	if !ok {
+		return string(e.Code)
+	}
+	return fillParams(template, e.Params)
+}
+
+// Error returns the English message followed by the cause, if any
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message() + ": " + e.Err.Error()
+	}
+	return e.Message()
+}
+
+// Unwrap returns the cause so errors.Is/As see through service errors
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// placeholderPattern matches {name} placeholders in message templates
+var placeholderPattern = regexp.MustCompile(`\{\w+\}`)
+
+// fillParams replaces the {name} placeholders of template with params.
+// Unknown placeholders are left as-is.
+func fillParams(template string, params ErrorParams) string {
+	return placeholderPattern.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		if value, ok := params[name]; ok {
+			return fmt.Sprint(value)
+		}
+		return placeholder
+	})
+}
+
+// ErrorCodeOf returns the code of the outermost service error in err's chain,
+// or ErrCodeInternal if there is none
+func ErrorCodeOf(err error) ErrorCode {
+	var serviceErr *Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr.Code
+	}
+	return ErrCodeInternal
+}
+
+// ToErrorInfo converts err into its serializable form. Service errors keep their
+// code and params; nested service errors become the Cause, other causes the Detail.
+func ToErrorInfo(err error) *models.ErrorInfo {
+	if err == nil {
+		return nil
+	}
+
+	var serviceErr *Error
+	if !errors.As(err, &serviceErr) {
+		return &models.ErrorInfo{
+			Code:    string(ErrCodeInternal),
+			Message: err.Error(),
+		}
+	}
+
+	info := &models.ErrorInfo{
+		Code:    string(serviceErr.Code),
+		Message: err.Error(),
+		Params:  map[string]interface{}(serviceErr.Params),
+	}
+	if serviceErr.Err != nil {
+		var cause *Error
+		if errors.As(serviceErr.Err, &cause) {
+			info.Cause = ToErrorInfo(serviceErr.Err)
+		} else {
+			info.Detail = serviceErr.Err.Error()
+		}
+	}
+	return info
+}
+
+// FormatError is a Wails ErrorFormatter: bound methods reject their promise
+// with a models.ErrorInfo instead of a plain string
+func FormatError(err error) any {
+	return ToErrorInfo(err)
+}
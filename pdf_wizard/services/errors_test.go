@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"pdf_wizard/models"
+)
+
+func TestError_Message(t *testing.T) {
+	err := NewError(ErrCodeSplitStartPage, ErrorParams{"index": 2, "page": 9, "totalPages": 5}, nil)
+	expected := "split 2: start page 9 is out of range (1-5)"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+
+	// Unknown placeholders are left as-is rather than dropped
+	err = NewError(ErrCodeFileNotFound, nil, nil)
+	if err.Error() != "file not found: {path}" {
+		t.Errorf("Unexpected message: %q", err.Error())
+	}
+}
+
+func TestError_WrapsCause(t *testing.T) {
+	cause := errors.New("disk full")
+	err := NewError(ErrCodeWriteOutput, ErrorParams{"path": "out.pdf"}, cause)
+	if err.Error() != "failed to write output file out.pdf: disk full" {
+		t.Errorf("Unexpected message: %q", err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Expected errors.Is to find the cause")
+	}
+
+	cancelled := checkCancelled(cancelledContext())
+	if !errors.Is(cancelled, context.Canceled) {
+		t.Error("Expected cancellation error to wrap context.Canceled")
+	}
+	if ErrorCodeOf(cancelled) != ErrCodeCancelled {
+		t.Errorf("Expected %s, got %s", ErrCodeCancelled, ErrorCodeOf(cancelled))
+	}
+}
+
+func TestErrorCodeOf(t *testing.T) {
+	wrapped := fmt.Errorf("context: %w", NewError(ErrCodeNotPDF, ErrorParams{"path": "a.txt"}, nil))
+	if code := ErrorCodeOf(wrapped); code != ErrCodeNotPDF {
+		t.Errorf("Expected %s, got %s", ErrCodeNotPDF, code)
+	}
+	if code := ErrorCodeOf(errors.New("plain")); code != ErrCodeInternal {
+		t.Errorf("Expected %s for plain errors, got %s", ErrCodeInternal, code)
+	}
+}
+
+func TestToErrorInfo(t *testing.T) {
+	inner := NewError(ErrCodeFileNotFound, ErrorParams{"path": "/x.pdf"}, nil)
+	outer := NewError(ErrCodeInvalidInputAt, ErrorParams{"index": 1}, inner)
+
+	info := ToErrorInfo(outer)
+	if info.Code != string(ErrCodeInvalidInputAt) || info.Params["index"] != 1 {
+		t.Errorf("Unexpected info: %+v", info)
+	}
+	if info.Message != "input file 1: file not found: /x.pdf" {
+		t.Errorf("Unexpected message: %q", info.Message)
+	}
+	if info.Cause == nil || info.Cause.Code != string(ErrCodeFileNotFound) || info.Cause.Params["path"] != "/x.pdf" {
+		t.Errorf("Expected nested cause, got %+v", info.Cause)
+	}
+
+	info = ToErrorInfo(NewError(ErrCodeMergeFailed, nil, errors.New("pdfcpu: bad xref")))
+	if info.Cause != nil || info.Detail != "pdfcpu: bad xref" {
+		t.Errorf("Expected detail for non-service cause, got %+v", info)
+	}
+
+	info = ToErrorInfo(errors.New("plain"))
+	if info.Code != string(ErrCodeInternal) || info.Message != "plain" {
+		t.Errorf("Unexpected info for plain error: %+v", info)
+	}
+
+	if ToErrorInfo(nil) != nil {
+		t.Error("Expected nil info for nil error")
+	}
+}
+
+func TestErrorMessages_AllCodesHaveTemplates(t *testing.T) {
+	codes := []ErrorCode{
+		ErrCodeInternal, ErrCodeCancelled, ErrCodeJobNotFound, ErrCodeInvalidLanguage,
+		ErrCodeFilePathEmpty, ErrCodeFileNotFound, ErrCodeFileAccess, ErrCodePathIsDirectory, ErrCodeNotPDF,
+		ErrCodeOutputDirNotFound, ErrCodeOutputDirAccess, ErrCodeOutputNotDirectory, ErrCodeNoFileSelected,
+		ErrCodePDFRead, ErrCodePageCount, ErrCodePDFEncrypted, ErrCodeIncorrectPassword, ErrCodePermissionDenied,
+		ErrCodeDecryptRequired, ErrCodeNoInputFiles, ErrCodeEmptyInputPath, ErrCodeInvalidInput,
+		ErrCodeInvalidInputAt, ErrCodeUnreadableInput, ErrCodeOutputFilenameEmpty, ErrCodeOutputNotCreated,
+		ErrCodeCreateOutput, ErrCodeWriteOutput, ErrCodeMoveOutput, ErrCodeInspectOutput, ErrCodeOutputExists,
+		ErrCodeConflictPolicy,
+		ErrCodeMergeFontEncoding, ErrCodeMergeFailed, ErrCodeMergePageRange, ErrCodeBookmarksInvalid,
+		ErrCodeCollatePageCounts, ErrCodeCollateFailed, ErrCodeSplitStartPage, ErrCodeSplitEndPage,
+		ErrCodeSplitFilenameEmpty, ErrCodeDuplicateFilename, ErrCodeSplitFailed, ErrCodeSplitPagesPerFile,
+		ErrCodeFilenamePattern, ErrCodeOutlineLevel, ErrCodeOutlineRead, ErrCodeNoBookmarks, ErrCodeSplitMaxSize,
+		ErrCodeSplitPageTooLarge, ErrCodeRotationStartPage,
+		ErrCodeRotationEndPage, ErrCodeRotationAngle, ErrCodeRotateFailed, ErrCodeWatermarkTextEmpty,
+		ErrCodeFontSize, ErrCodeOpacity, ErrCodeFontColor, ErrCodeWatermarkCreate, ErrCodeWatermarkFailed,
+		ErrCodeOwnerPasswordEmpty, ErrCodeEncryptionAlgorithm, ErrCodeEncryptFailed,
+		ErrCodeAlreadyEncrypted, ErrCodeNotEncrypted, ErrCodeDecryptFailed, ErrCodeNoPermissionChange,
+		ErrCodePermissionsFailed, ErrCodeOptimizeFailed, ErrCodeExtractFailed,
+		ErrCodeDeleteAllPages, ErrCodeDeletePagesFailed, ErrCodeReorderMode, ErrCodeReorderDuplicatePage,
+		ErrCodeReorderMissingPages, ErrCodeMovePosition, ErrCodeMovePages, ErrCodeMoveTarget,
+		ErrCodeMoveTargetMoved, ErrCodeReorderFailed, ErrCodeInsertMode, ErrCodeInsertBlankCount,
+		ErrCodeInsertPosition, ErrCodeInsertFailed,
+		ErrCodeInvalidPageRange, ErrCodePageRangeEmpty, ErrCodePageRangeFormat, ErrCodePageRangeNoStart,
+		ErrCodeInvalidPageNumber, ErrCodePageOutOfRange, ErrCodeEndPageInvalid, ErrCodeNoPagesSelected,
+		ErrCodeInvalidColor,
+	}
+	for _, code := range codes {
+		if _, ok := errorMessages[code]; !ok {
+			t.Errorf("Missing message template for %s", code)
+		}
+	}
+}
+
+func TestWarningMessages_AllCodesHaveTemplates(t *testing.T) {
+	codes := []WarningCode{
+		WarnCodeDuplicateInput, WarnCodeCollateSameFile, WarnCodePagesNotInSplit, WarnCodeRotationsOverlap,
+		WarnCodeWatermarkInvisible, WarnCodeSamePasswords, WarnCodeNotSmaller, WarnCodeOrderUnchanged,
+	}
+	for _, code := range codes {
+		if _, ok := warningMessages[code]; !ok {
+			t.Errorf("Missing message template for %s", code)
+		}
+	}
+}
+
+// frontendTemplates returns the English templates the frontend catalogs must
+// translate: every error and warning code
+func frontendTemplates(t *testing.T) map[string]string {
+	templates := make(map[string]string)
+	for code, template := range errorMessages {
+		templates[string(code)] = template
+	}
+	for code, template := range warningMessages {
+		if _, ok := templates[string(code)]; ok {
+			t.Fatalf("Warning code %s is also an error code", code)
+		}
+		templates[string(code)] = template
+	}
+	return templates
+}
+
+func TestErrorMessages_MatchFrontendCatalog(t *testing.T) {
+	// The frontend localizes errors and warnings by code; its English catalog
+	// mirrors errorMessages and warningMessages
+	catalog, err := os.ReadFile(filepath.Join("..", "frontend", "src", "utils", "i18n", "errors", "en.ts"))
+	if err != nil {
+		t.Fatalf("Failed to read the frontend error catalog: %v", err)
+	}
+	for code, template := range frontendTemplates(t) {
+		entry := fmt.Sprintf("%s: '%s',", code, template)
+		if !strings.Contains(string(catalog), entry) {
+			t.Errorf("Frontend error catalog has no entry %s", entry)
+		}
+	}
+}
+
+func TestErrorMessages_EveryLanguageHasCatalog(t *testing.T) {
+	// Every UI language needs an error catalog translating every code with the same placeholders
+	i18nDir := filepath.Join("..", "frontend", "src", "utils", "i18n")
+	languages, err := filepath.Glob(filepath.Join(i18nDir, "*.ts"))
+	if err != nil {
+		t.Fatalf("Failed to list the frontend languages: %v", err)
+	}
+	checked := 0
+	for _, languageFile := range languages {
+		name := filepath.Base(languageFile)
+		if name == "index.ts" || name == "types.ts" || name == "constants.ts" {
+			continue
+		}
+		checked++
+		catalog, err := os.ReadFile(filepath.Join(i18nDir, "errors", name))
+		if err != nil {
+			t.Errorf("Language %s has no error catalog: %v", name, err)
+			continue
+		}
+		entries := make(map[string]string)
+		for _, line := range strings.Split(string(catalog), "\n") {
+			code, template, ok := strings.Cut(strings.TrimSpace(line), ": '")
+			if ok && strings.HasSuffix(template, "',") {
+				entries[code] = strings.TrimSuffix(template, "',")
+			}
+		}
+		for code, english := range frontendTemplates(t) {
+			template, ok := entries[code]
+			if !ok {
+				t.Errorf("Error catalog %s has no entry for %s", name, code)
+				continue
+			}
+			expected := placeholderPattern.FindAllString(english, -1)
+			actual := placeholderPattern.FindAllString(template, -1)
+			slices.Sort(expected)
+			slices.Sort(actual)
+			if !slices.Equal(expected, actual) {
+				t.Errorf("Error catalog %s: %s has placeholders %v, expected %v", name, code, actual, expected)
+			}
+		}
+	}
+	if checked < 12 {
+		t.Errorf("Expected at least 12 languages, found %d", checked)
+	}
+}
+
+func TestPDFService_ErrorCodes(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func() error
+		code ErrorCode
+	}{
+		{"no inputs", func() error {
+			_, err := service.MergePDFs(context.Background(), nil, testDir, "out", models.MergeOptions{}, models.OutputOptions{})
+			return err
+		}, ErrCodeNoInputFiles},
+		{"missing input", func() error {
+			_, err := service.MergePDFs(context.Background(), []string{filepath.Join(testDir, "missing.pdf")}, testDir, "out", models.MergeOptions{}, models.OutputOptions{})
+			return err
+		}, ErrCodeInvalidInputAt},
+		{"split start page", func() error {
+			_, err := service.SplitPDF(context.Background(), inputPDF, []models.SplitDefinition{{StartPage: 4, EndPage: 4, Filename: "a"}}, testDir, models.OutputOptions{})
+			return err
+		}, ErrCodeSplitStartPage},
+		{"duplicate filename", func() error {
+			splits := []models.SplitDefinition{{StartPage: 1, EndPage: 1, Filename: "a"}, {StartPage: 2, EndPage: 2, Filename: "a"}}
+			_, err := service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{})
+			return err
+		}, ErrCodeDuplicateFilename},
+		{"duplicate filename in another case", func() error {
+			splits := []models.SplitDefinition{{StartPage: 1, EndPage: 1, Filename: "Intro"}, {StartPage: 2, EndPage: 2, Filename: "intro"}}
+			_, err := service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{})
+			return err
+		}, ErrCodeDuplicateFilename},
+		{"rotation angle", func() error {
+			_, err := service.RotatePDF(context.Background(), inputPDF, []models.RotateDefinition{{StartPage: 1, EndPage: 1, Rotation: 45}}, testDir, "out", models.OutputOptions{})
+			return err
+		}, ErrCodeRotationAngle},
+		{"watermark page range", func() error {
+			watermark := models.WatermarkDefinition{TextConfig: models.TextWatermarkConfig{Text: "X", FontSize: 10, Opacity: 0.5}, PageRange: "9"}
+			_, err := service.ApplyWatermark(context.Background(), inputPDF, watermark, testDir, "out", models.OutputOptions{})
+			return err
+		}, ErrCodeInvalidPageRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run()
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if code := ErrorCodeOf(err); code != tt.code {
+				t.Errorf("Expected code %s, got %s (%v)", tt.code, code, err)
+			}
+		})
+	}
+}
+
+// cancelledContext returns a context that is already cancelled
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
@@ -0,0 +1,12 @@
+package services
+
+// EventEmitter sends named events with an optional payload to the frontend
+type EventEmitter interface {
+	Emit(name string, data ...interface{})
+}
+
+// NoopEventEmitter drops all events, for tests and headless use
+type NoopEventEmitter struct{}
+
+// Emit does nothing
+func (NoopEventEmitter) Emit(name string, data ...interface{}) {}
@@ -1,38 +1,51 @@
 package services
 
 import (
-	"context"
-	"fmt"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
-	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
 
 	"pdf_wizard/models"
 )
 
-// FileService handles file operations and dialogs
+// pdfFileFilters restricts file dialogs to PDF files
+var pdfFileFilters = []FileFilter{
+	{
+		DisplayName: "PDF files",
+		Pattern:     "*.pdf",
+	},
+}
+
+// FileService handles file operations and dialogs. It also holds the
+// passwords of password-protected input PDFs for the running session.
 type FileService struct {
-	ctx context.Context
+	dialogs DialogProvider
+
+	mu        sync.RWMutex
+	passwords map[string]string // Absolute path -> password
 }
 
-// NewFileService creates a new FileService instance
-func NewFileService(ctx context.Context) *FileService {
-	return &FileService{ctx: ctx}
+// NewFileService creates a new FileService instance.
+// If dialogs is nil, file dialogs are unavailable (headless use).
+func NewFileService(dialogs DialogProvider) *FileService {
+	if dialogs == nil {
+		dialogs = &FakeDialogProvider{}
+	}
+	return &FileService{dialogs: dialogs, passwords: make(map[string]string)}
 }
 
 // SelectPDFFiles opens a file dialog to select multiple PDF files
 func (s *FileService) SelectPDFFiles() ([]string, error) {
-	selection, err := runtime.OpenMultipleFilesDialog(s.ctx, runtime.OpenDialogOptions{
-		Title: "Select PDF Files",
-		Filters: []runtime.FileFilter{
-			{
-				DisplayName: "PDF files",
-				Pattern:     "*.pdf",
-			},
-		},
+	selection, err := s.dialogs.OpenMultipleFilesDialog(DialogOptions{
+		Title:   "Select PDF Files",
+		Filters: pdfFileFilters,
 	})
 	if err != nil {
 		return nil, err
@@ -42,27 +55,22 @@ func (s *FileService) SelectPDFFiles() ([]string, error) {
 
 // SelectPDFFile opens a file dialog to select a single PDF file
 func (s *FileService) SelectPDFFile() (string, error) {
-	selection, err := runtime.OpenFileDialog(s.ctx, runtime.OpenDialogOptions{
-		Title: "Select PDF File",
-		Filters: []runtime.FileFilter{
-			{
-				DisplayName: "PDF files",
-				Pattern:     "*.pdf",
-			},
-		},
+	selection, err := s.dialogs.OpenFileDialog(DialogOptions{
+		Title:   "Select PDF File",
+		Filters: pdfFileFilters,
 	})
 	if err != nil {
 		return "", err
 	}
 	if selection == "" {
-		return "", fmt.Errorf("no file selected")
+		return "", NewError(ErrCodeNoFileSelected, nil, nil)
 	}
 	return selection, nil
 }
 
 // SelectOutputDirectory opens a directory dialog to select output directory
 func (s *FileService) SelectOutputDirectory() (string, error) {
-	selection, err := runtime.OpenDirectoryDialog(s.ctx, runtime.OpenDialogOptions{
+	selection, err := s.dialogs.OpenDirectoryDialog(DialogOptions{
 		Title: "Select Output Directory",
 	})
 	if err != nil {
@@ -96,16 +104,17 @@ func (s *FileService) GetPDFPageCount(path string) (int, error) {
 	}
 
 	// Use pdfcpu to read the PDF and get page count
-	ctx, err := api.ReadContextFile(path)
+	ctx, err := s.readContext(path)
 	if err != nil {
-		return 0, fmt.Errorf("failed to read PDF: %w", err)
+		return 0, err
 	}
 
 	pageCount := ctx.PageCount
 	return pageCount, nil
 }
 
-// GetPDFMetadata retrieves PDF file metadata including page count
+// GetPDFMetadata retrieves PDF file metadata including page count.
+// Password-protected files fail with PDF_ENCRYPTED until SetPDFPassword is called.
 func (s *FileService) GetPDFMetadata(path string) (models.PDFMetadata, error) {
 	info, err := os.Stat(path)
 	if err != nil {
@@ -113,9 +122,15 @@ func (s *FileService) GetPDFMetadata(path string) (models.PDFMetadata, error) {
 	}
 
 	// Get page count
-	pageCount, err := s.GetPDFPageCount(path)
+	if err := validatePDFFile(path); err != nil {
+		return models.PDFMetadata{}, NewError(ErrCodePageCount, ErrorParams{"path": path}, err)
+	}
+	ctx, err := s.readContext(path)
 	if err != nil {
-		return models.PDFMetadata{}, fmt.Errorf("failed to get page count: %w", err)
+		if isPasswordError(err) {
+			return models.PDFMetadata{}, err
+		}
+		return models.PDFMetadata{}, NewError(ErrCodePageCount, ErrorParams{"path": path}, err)
 	}
 
 	return models.PDFMetadata{
@@ -124,6 +139,110 @@ func (s *FileService) GetPDFMetadata(path string) (models.PDFMetadata, error) {
 		Size:         info.Size(),
 		LastModified: info.ModTime().Format(time.RFC3339),
 		IsPDF:        isPDFFile(path),
-		TotalPages:   pageCount,
+		TotalPages:   ctx.PageCount,
+		Encrypted:    ctx.Encrypt != nil,
 	}, nil
 }
+
+// SetPDFPassword remembers the password of a password-protected PDF, so
+// metadata calls and operations can open it. The password is checked first;
+// an empty password forgets the stored one.
+func (s *FileService) SetPDFPassword(path, password string) error {
+	if err := validatePDFFile(path); err != nil {
+		return err
+	}
+	key := passwordKey(path)
+	if password == "" {
+		s.mu.Lock()
+		delete(s.passwords, key)
+		s.mu.Unlock()
+		return nil
+	}
+
+	if _, err := readContextWithPassword(path, password); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.passwords[key] = password
+	s.mu.Unlock()
+	return nil
+}
+
+// password returns the stored password of path, or ""
+func (s *FileService) password(path string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.passwords[passwordKey(path)]
+}
+
+// withPassword returns a copy of config that opens path with its stored password
+func (s *FileService) withPassword(config *model.Configuration, path string) *model.Configuration {
+	c := *config
+	// The password may be either the user or the owner password
+	c.UserPW = s.password(path)
+	c.OwnerPW = c.UserPW
+	return &c
+}
+
+// readContext reads a PDF with its stored password without validating it
+// and counts its pages
+func (s *FileService) readContext(path string) (*model.Context, error) {
+	return readContextWithPassword(path, s.password(path))
+}
+
+// readContextWithPassword is readContext with an explicit password
+func readContextWithPassword(path, password string) (*model.Context, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, NewError(ErrCodePDFRead, ErrorParams{"path": path}, err)
+	}
+	defer f.Close()
+
+	ctx, err := api.ReadContext(f, passwordConfiguration(password, password))
+	if err != nil {
+		return nil, readError(path, password, err)
+	}
+	if err := ctx.EnsurePageCount(); err != nil {
+		return nil, NewError(ErrCodePDFRead, ErrorParams{"path": path}, err)
+	}
+	return ctx, nil
+}
+
+// isEncrypted reports whether path is encrypted. It fails with PDF_ENCRYPTED
+// if the file needs a password that has not been set.
+func (s *FileService) isEncrypted(path string) (bool, error) {
+	ctx, err := s.readContext(path)
+	if err != nil {
+		return false, err
+	}
+	return ctx.Encrypt != nil, nil
+}
+
+// readError converts an error reading path with password into a service error,
+// telling missing and incorrect passwords and restricted permissions apart
+func readError(path, password string, err error) error {
+	switch {
+	case errors.Is(err, pdfcpu.ErrWrongPassword) && password == "":
+		return NewError(ErrCodePDFEncrypted, ErrorParams{"path": path}, nil)
+	case errors.Is(err, pdfcpu.ErrWrongPassword), strings.Contains(err.Error(), "provide the owner password"):
+		return NewError(ErrCodeIncorrectPassword, ErrorParams{"path": path}, nil)
+	case strings.Contains(err.Error(), "restricted via pdfcpu's permission bits"):
+		return NewError(ErrCodePermissionDenied, ErrorParams{"path": path}, err)
+	}
+	return NewError(ErrCodePDFRead, ErrorParams{"path": path}, err)
+}
+
+// isPasswordError reports whether err means a PDF cannot be opened without (another) password
+func isPasswordError(err error) bool {
+	code := ErrorCodeOf(err)
+	return code == ErrCodePDFEncrypted || code == ErrCodeIncorrectPassword
+}
+
+// passwordKey normalizes path for the password map
+func passwordKey(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
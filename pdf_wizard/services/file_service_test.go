@@ -1,7 +1,7 @@
 package services
 
 import (
-	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -94,7 +94,7 @@ func cleanupTestDir(t *testing.T, dir string) {
 }
 
 func TestFileService_GetFileMetadata(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -136,7 +136,7 @@ func TestFileService_GetFileMetadata(t *testing.T) {
 }
 
 func TestFileService_GetPDFPageCount(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -160,7 +160,7 @@ func TestFileService_GetPDFPageCount(t *testing.T) {
 }
 
 func TestFileService_GetPDFMetadata(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -195,8 +195,54 @@ func TestFileService_GetPDFMetadata(t *testing.T) {
 	}
 }
 
+func TestFileService_SetPDFPassword(t *testing.T) {
+	service := NewFileService(&FakeDialogProvider{})
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+
+	// Without a password the file is reported as encrypted
+	if _, err := service.GetPDFMetadata(inputPDF); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+		t.Fatalf("Expected %s, got %v", ErrCodePDFEncrypted, err)
+	}
+
+	// A wrong password is rejected and not stored
+	if err := service.SetPDFPassword(inputPDF, "wrong"); ErrorCodeOf(err) != ErrCodeIncorrectPassword {
+		t.Fatalf("Expected %s, got %v", ErrCodeIncorrectPassword, err)
+	}
+	if _, err := service.GetPDFMetadata(inputPDF); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+		t.Fatalf("Expected %s after a wrong password, got %v", ErrCodePDFEncrypted, err)
+	}
+
+	// Both the user and the owner password open the file
+	for _, password := range []string{"user", "owner"} {
+		if err := service.SetPDFPassword(inputPDF, password); err != nil {
+			t.Fatalf("SetPDFPassword(%q) failed: %v", password, err)
+		}
+		metadata, err := service.GetPDFMetadata(inputPDF)
+		if err != nil {
+			t.Fatalf("GetPDFMetadata failed: %v", err)
+		}
+		if !metadata.Encrypted || metadata.TotalPages != 2 {
+			t.Errorf("Expected an encrypted 2-page PDF, got %+v", metadata)
+		}
+	}
+
+	// An empty password forgets the stored one
+	if err := service.SetPDFPassword(inputPDF, ""); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+	if _, err := service.GetPDFPageCount(inputPDF); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+		t.Errorf("Expected %s after forgetting the password, got %v", ErrCodePDFEncrypted, err)
+	}
+}
+
 func TestFileService_GetFileMetadata_NonExistentFile(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	_, err := service.GetFileMetadata("/nonexistent/file.pdf")
 	if err == nil {
@@ -205,7 +251,7 @@ func TestFileService_GetFileMetadata_NonExistentFile(t *testing.T) {
 }
 
 func TestFileService_GetFileMetadata_NonPDFFile(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -227,7 +273,7 @@ func TestFileService_GetFileMetadata_NonPDFFile(t *testing.T) {
 }
 
 func TestFileService_GetPDFPageCount_NonExistentFile(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	_, err := service.GetPDFPageCount("/nonexistent/file.pdf")
 	if err == nil {
@@ -236,7 +282,7 @@ func TestFileService_GetPDFPageCount_NonExistentFile(t *testing.T) {
 }
 
 func TestFileService_GetPDFPageCount_NonPDFFile(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -254,7 +300,7 @@ func TestFileService_GetPDFPageCount_NonPDFFile(t *testing.T) {
 }
 
 func TestFileService_GetPDFMetadata_NonExistentFile(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	_, err := service.GetPDFMetadata("/nonexistent/file.pdf")
 	if err == nil {
@@ -263,7 +309,7 @@ func TestFileService_GetPDFMetadata_NonExistentFile(t *testing.T) {
 }
 
 func TestFileService_GetPDFMetadata_NonPDFFile(t *testing.T) {
-	service := NewFileService(context.Background())
+	service := NewFileService(&FakeDialogProvider{})
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -279,3 +325,88 @@ func TestFileService_GetPDFMetadata_NonPDFFile(t *testing.T) {
 		t.Error("Expected error for non-PDF file, got nil")
 	}
 }
+
+func TestFileService_SelectPDFFiles(t *testing.T) {
+	dialogs := &FakeDialogProvider{}
+	dialogs.QueueSelection("/tmp/a.pdf", "/tmp/b.pdf")
+	service := NewFileService(dialogs)
+
+	selection, err := service.SelectPDFFiles()
+	if err != nil {
+		t.Fatalf("SelectPDFFiles failed: %v", err)
+	}
+	if len(selection) != 2 || selection[0] != "/tmp/a.pdf" || selection[1] != "/tmp/b.pdf" {
+		t.Errorf("Unexpected selection: %v", selection)
+	}
+
+	calls := dialogs.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("Expected 1 dialog call, got %d", len(calls))
+	}
+	if len(calls[0].Filters) != 1 || calls[0].Filters[0].Pattern != "*.pdf" {
+		t.Errorf("Expected PDF filter, got %+v", calls[0].Filters)
+	}
+}
+
+func TestFileService_SelectPDFFile(t *testing.T) {
+	dialogs := &FakeDialogProvider{}
+	dialogs.QueueSelection("/tmp/input.pdf")
+	service := NewFileService(dialogs)
+
+	selection, err := service.SelectPDFFile()
+	if err != nil {
+		t.Fatalf("SelectPDFFile failed: %v", err)
+	}
+	if selection != "/tmp/input.pdf" {
+		t.Errorf("Expected /tmp/input.pdf, got %s", selection)
+	}
+}
+
+func TestFileService_SelectPDFFile_Cancelled(t *testing.T) {
+	dialogs := &FakeDialogProvider{}
+	dialogs.QueueCancel()
+	service := NewFileService(dialogs)
+
+	if _, err := service.SelectPDFFile(); err == nil {
+		t.Error("Expected error for cancelled dialog, got nil")
+	}
+}
+
+func TestFileService_SelectOutputDirectory(t *testing.T) {
+	dialogs := &FakeDialogProvider{}
+	dialogs.QueueSelection("/tmp/output")
+	dialogs.QueueError(errors.New("dialog failed"))
+	service := NewFileService(dialogs)
+
+	selection, err := service.SelectOutputDirectory()
+	if err != nil {
+		t.Fatalf("SelectOutputDirectory failed: %v", err)
+	}
+	if selection != "/tmp/output" {
+		t.Errorf("Expected /tmp/output, got %s", selection)
+	}
+
+	if _, err := service.SelectOutputDirectory(); err == nil || err.Error() != "dialog failed" {
+		t.Errorf("Expected scripted error, got %v", err)
+	}
+}
+
+func TestFileService_Headless(t *testing.T) {
+	service := NewFileService(nil)
+
+	if _, err := service.SelectPDFFiles(); !errors.Is(err, ErrDialogUnavailable) {
+		t.Errorf("Expected ErrDialogUnavailable, got %v", err)
+	}
+
+	// Metadata operations work without dialogs
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	testPDF := filepath.Join(testDir, "test.pdf")
+	if err := createTestPDF(testPDF); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	if _, err := service.GetPDFMetadata(testPDF); err != nil {
+		t.Errorf("GetPDFMetadata failed: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// operationIDKey is the context key holding the ID of the running operation
+type operationIDKey struct{}
+
+// NewOperationID returns a random identifier for an operation
+func NewOperationID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("op-%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
+
+// WithOperationID returns a copy of ctx carrying the operation ID. PDFService
+// reports progress under this ID, so callers can correlate progress events
+// with the operation they started (and cancel it).
+func WithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// OperationIDFromContext returns the operation ID stored in ctx, or "" if none
+func OperationIDFromContext(ctx context.Context) string {
+	operationID, _ := ctx.Value(operationIDKey{}).(string)
+	return operationID
+}
+
+// checkCancelled returns an error wrapping ctx.Err() once ctx is done
+func checkCancelled(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return NewError(ErrCodeCancelled, nil, err)
+	}
+	return nil
+}
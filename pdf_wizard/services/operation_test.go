@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewOperationID_Unique(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := NewOperationID()
+		if seen[id] {
+			t.Fatalf("Duplicate operation ID %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestOperationIDFromContext(t *testing.T) {
+	if id := OperationIDFromContext(context.Background()); id != "" {
+		t.Errorf("Expected empty operation ID, got %s", id)
+	}
+
+	ctx := WithOperationID(context.Background(), "op-123")
+	if id := OperationIDFromContext(ctx); id != "op-123" {
+		t.Errorf("Expected op-123, got %s", id)
+	}
+
+	// Progress events use the operation ID from the context
+	recorder := &progressRecorder{}
+	service := NewPDFService(NewFileService(nil), recorder)
+	service.startOperation(ctx, OperationMerge, progressPhase{PhaseValidating, 1}).report(PhaseValidating, 0, 1)
+	events := recorder.Events()
+	if len(events) != 1 || events[0].OperationID != "op-123" {
+		t.Errorf("Expected event with operation ID op-123, got %+v", events)
+	}
+}
+
+func TestCheckCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := checkCancelled(ctx); err != nil {
+		t.Errorf("Expected nil error before cancel, got %v", err)
+	}
+	cancel()
+	if err := checkCancelled(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/filter"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+
+	"pdf_wizard/models"
+)
+
+// OptimizePDF rewrites a PDF to make it smaller: duplicate fonts, images and
+// content streams are shared, unused objects are dropped and uncompressed
+// streams are compressed. The result reports the input and output sizes.
+func (s *PDFService) OptimizePDF(ctx context.Context, inputPath string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationOptimize,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 40},
+		progressPhase{PhaseProcessing, 40},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationOptimize)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Fail early with PDF_ENCRYPTED if a password is missing
+	if _, _, err := s.inspectInput(inputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseReading, 0, 1)
+
+	// Reading with the optimize command also optimizes resource dictionaries
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+	config.Cmd = model.OPTIMIZE
+	config.OptimizeDuplicateContentStreams = true
+	pdfCtx, err := readOptimizedContext(inputPath, config)
+	if err != nil {
+		return models.OperationResult{}, readError(inputPath, config.UserPW, err)
+	}
+	progress.report(PhaseReading, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseProcessing, 0, 1)
+	if err := compressStreams(pdfCtx); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeOptimizeFailed, nil, err)
+	}
+	progress.report(PhaseProcessing, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseWriting, 0, 1)
+
+	// The output keeps the input's encryption unless decrypt is set
+	if options.Decrypt {
+		removeEncryption(pdfCtx)
+	}
+	if err := writeContextFile(pdfCtx, outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := result.addOutputWithConfig(outputPath, config); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	result.reportSizes(info.Size())
+	if result.result.SizeAfter >= result.result.SizeBefore {
+		result.warn(WarnCodeNotSmaller, nil)
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// optimizeContext runs the full optimization of OptimizePDF on an in-memory
+// document, e.g. a merged one
+func optimizeContext(ctx *model.Context) error {
+	ctx.Cmd = model.OPTIMIZE
+	ctx.OptimizeResourceDicts = true
+	ctx.OptimizeDuplicateContentStreams = true
+	if err := api.OptimizeContext(ctx); err != nil {
+		return err
+	}
+	return compressStreams(ctx)
+}
+
+// compressStreams flate-encodes streams that are stored without a filter,
+// keeping the original where compression does not make it smaller
+func compressStreams(ctx *model.Context) error {
+	for _, entry := range ctx.Table {
+		if entry == nil || entry.Free {
+			continue
+		}
+		sd, ok := entry.Object.(types.StreamDict)
+		if !ok || sd.FilterPipeline != nil || sd.Raw == nil {
+			continue
+		}
+		if _, found := sd.Find("Filter"); found {
+			continue
+		}
+
+		compressed := sd
+		compressed.Dict = sd.Dict.Clone().(types.Dict)
+		compressed.Content = sd.Raw
+		compressed.FilterPipeline = []types.PDFFilter{{Name: filter.Flate}}
+		compressed.InsertName("Filter", filter.Flate)
+		if err := compressed.Encode(); err != nil {
+			return err
+		}
+		if len(compressed.Raw) < len(sd.Raw) {
+			entry.Object = compressed
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+
+	"pdf_wizard/models"
+)
+
+// createUncompressedTestPDF creates a one-page PDF whose content stream is
+// stored without compression, so optimizing it saves space
+func createUncompressedTestPDF(path string) error {
+	var content strings.Builder
+	content.WriteString("BT\n/F1 12 Tf\n")
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&content, "72 %d Td\n(Uncompressed line %d) Tj\n", 700-i%50, i)
+	}
+	content.WriteString("ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF", len(objects)+1, xref)
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+func TestPDFService_OptimizePDF(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createUncompressedTestPDF(inputPDF); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	info, err := os.Stat(inputPDF)
+	if err != nil {
+		t.Fatalf("Failed to stat input: %v", err)
+	}
+
+	result, err := service.OptimizePDF(context.Background(), inputPDF, testDir, "optimized", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("OptimizePDF failed: %v", err)
+	}
+	if len(result.Outputs) != 1 || result.Outputs[0].PageCount != 1 {
+		t.Fatalf("Expected one 1-page output, got %+v", result.Outputs)
+	}
+	if result.SizeBefore != info.Size() {
+		t.Errorf("Expected SizeBefore %d, got %d", info.Size(), result.SizeBefore)
+	}
+	if result.SizeAfter != result.Outputs[0].Size {
+		t.Errorf("Expected SizeAfter %d, got %d", result.Outputs[0].Size, result.SizeAfter)
+	}
+	if result.SizeAfter >= result.SizeBefore {
+		t.Errorf("Expected the output to be smaller than %d bytes, got %d", result.SizeBefore, result.SizeAfter)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Expected no warnings, got %v", result.Warnings)
+	}
+	if err := api.ValidateFile(filepath.Join(testDir, "optimized.pdf"), nil); err != nil {
+		t.Errorf("Optimized PDF is invalid: %v", err)
+	}
+}
+
+func TestPDFService_OptimizePDF_EncryptedInput(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+
+	if _, err := service.OptimizePDF(context.Background(), inputPDF, testDir, "out", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+		t.Fatalf("Expected %s, got %v", ErrCodePDFEncrypted, err)
+	}
+	if err := fileService.SetPDFPassword(inputPDF, "owner"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	// The optimized document keeps its protection by default
+	if _, err := service.OptimizePDF(context.Background(), inputPDF, testDir, "protected", models.OutputOptions{}); err != nil {
+		t.Fatalf("OptimizePDF failed: %v", err)
+	}
+	if _, err := api.PageCountFile(filepath.Join(testDir, "protected.pdf")); err == nil {
+		t.Error("Expected the optimized PDF to stay password protected")
+	}
+}
+
+func TestPDFService_MergePDFs_Optimize(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	var inputs []string
+	for i := 0; i < 3; i++ {
+		path := filepath.Join(testDir, fmt.Sprintf("input%d.pdf", i))
+		if err := createUncompressedTestPDF(path); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+		inputs = append(inputs, path)
+	}
+
+	plain, err := service.MergePDFs(context.Background(), inputs, testDir, "plain", models.MergeOptions{}, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+	if plain.SizeBefore != 0 || plain.SizeAfter != 0 {
+		t.Errorf("Expected no sizes without optimize, got %d/%d", plain.SizeBefore, plain.SizeAfter)
+	}
+
+	optimized, err := service.MergePDFs(context.Background(), inputs, testDir, "optimized", models.MergeOptions{Optimize: true}, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+	// The size before is that of the same merge without optimizing, give or
+	// take the document ID and dates
+	if diff := optimized.SizeBefore - plain.Outputs[0].Size; diff < -16 || diff > 16 || optimized.SizeAfter != optimized.Outputs[0].Size {
+		t.Errorf("Expected sizes %d/%d, got %d/%d", plain.Outputs[0].Size, optimized.Outputs[0].Size, optimized.SizeBefore, optimized.SizeAfter)
+	}
+	if optimized.Outputs[0].PageCount != 3 {
+		t.Errorf("Expected 3 pages, got %d", optimized.Outputs[0].PageCount)
+	}
+	if optimized.Outputs[0].Size >= plain.Outputs[0].Size {
+		t.Errorf("Expected the optimized merge (%d bytes) to be smaller than the plain one (%d bytes)", optimized.Outputs[0].Size, plain.Outputs[0].Size)
+	}
+
+	// Merging a few pages of a large input reports the size of those pages, not of the input
+	largePDF := filepath.Join(testDir, "large.pdf")
+	if err := createNumberedTestPDF(largePDF, 20); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	info, err := os.Stat(largePDF)
+	if err != nil {
+		t.Fatalf("Failed to stat input: %v", err)
+	}
+	subset, err := service.MergePDFPages(context.Background(), []models.MergeInput{{Path: largePDF, Pages: "1"}, {Path: largePDF, Pages: "2"}}, testDir, "subset", models.MergeOptions{Optimize: true}, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("MergePDFPages failed: %v", err)
+	}
+	if subset.SizeBefore == 0 || subset.SizeBefore >= info.Size() {
+		t.Errorf("Expected the size before to be below the input's %d bytes, got %d", info.Size(), subset.SizeBefore)
+	}
+}
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"pdf_wizard/models"
+)
+
+// outputFile stages an output PDF in a temporary file in the target directory.
+// Nothing appears at the final path until commit succeeds, so a failed or
+// interrupted operation never replaces an existing file with a partial one.
+type outputFile struct {
+	path      string // Final output path
+	tempPath  string
+	file      *os.File
+	closed    bool
+	committed bool
+}
+
+// createOutput creates the temporary file for an output written to path.
+// The temporary file is hidden and named like ".report-123.pdf.tmp".
+func createOutput(path string) (*outputFile, error) {
+	name := strings.TrimSuffix(filepath.Base(path), PDFExtension)
+	file, err := os.CreateTemp(filepath.Dir(path), "."+name+"-*"+PDFExtension+TempFileSuffix)
+	if err != nil {
+		return nil, NewError(ErrCodeCreateOutput, ErrorParams{"path": path}, err)
+	}
+	return &outputFile{path: path, tempPath: file.Name(), file: file}, nil
+}
+
+// Write writes to the temporary file
+func (o *outputFile) Write(p []byte) (int, error) {
+	return o.file.Write(p)
+}
+
+// commit flushes the temporary file to disk and renames it to the final path,
+// replacing any existing file
+func (o *outputFile) commit() error {
+	if err := o.flush(); err != nil {
+		return err
+	}
+	if err := os.Rename(o.tempPath, o.path); err != nil {
+		o.abort()
+		return NewError(ErrCodeMoveOutput, ErrorParams{"path": o.path}, err)
+	}
+	o.committed = true
+	syncDir(filepath.Dir(o.path))
+	return nil
+}
+
+// flush syncs and closes the temporary file. The file is removed on failure.
+func (o *outputFile) flush() error {
+	if o.closed {
+		return nil
+	}
+	err := o.file.Sync()
+	if closeErr := o.file.Close(); err == nil {
+		err = closeErr
+	}
+	o.closed = true
+	if err == nil {
+		err = os.Chmod(o.tempPath, DefaultFilePerm)
+	}
+	if err != nil {
+		os.Remove(o.tempPath)
+		return NewError(ErrCodeWriteOutput, ErrorParams{"path": o.path}, err)
+	}
+	return nil
+}
+
+// abort discards the temporary file. It is a no-op after commit, so it can be deferred.
+func (o *outputFile) abort() {
+	if o.committed {
+		return
+	}
+	if !o.closed {
+		o.closed = true
+		o.file.Close()
+	}
+	os.Remove(o.tempPath)
+}
+
+// backupPath is where an existing file at o.path is kept while o is committed
+// as part of an outputSet, e.g. ".report-123.pdf.bak"
+func (o *outputFile) backupPath() string {
+	return strings.TrimSuffix(o.tempPath, TempFileSuffix) + BackupFileSuffix
+}
+
+// outputSet stages several outputs and commits them all or none
+type outputSet struct {
+	outputs []*outputFile
+}
+
+// stage writes an output to its temporary file and flushes it. Nothing is
+// written to path until commit.
+func (s *outputSet) stage(path string, write func(w io.Writer) error) (*outputFile, error) {
+	out, err := createOutput(path)
+	if err != nil {
+		return nil, err
+	}
+	s.outputs = append(s.outputs, out)
+	if err := write(out); err != nil {
+		return nil, err
+	}
+	if err := out.flush(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// commit moves all staged outputs into place. Existing files are moved aside
+// first; if any output cannot be moved into place, the outputs committed so
+// far are removed and the previous files are restored.
+func (s *outputSet) commit() error {
+	type replaced struct {
+		out    *outputFile
+		backup string // "" if there was no existing file
+	}
+	var done []replaced
+	rollback := func() {
+		for i := len(done) - 1; i >= 0; i-- {
+			if done[i].backup != "" {
+				os.Rename(done[i].backup, done[i].out.path)
+			} else {
+				os.Remove(done[i].out.path)
+			}
+		}
+		s.abort()
+	}
+
+	for _, out := range s.outputs {
+		backup := ""
+		if info, err := os.Lstat(out.path); err == nil {
+			if info.IsDir() {
+				rollback()
+				return NewError(ErrCodeMoveOutput, ErrorParams{"path": out.path}, NewError(ErrCodePathIsDirectory, ErrorParams{"path": out.path}, nil))
+			}
+			backup = out.backupPath()
+			if err := os.Rename(out.path, backup); err != nil {
+				rollback()
+				return NewError(ErrCodeMoveOutput, ErrorParams{"path": out.path}, err)
+			}
+		}
+		if err := os.Rename(out.tempPath, out.path); err != nil {
+			if backup != "" {
+				os.Rename(backup, out.path)
+			}
+			rollback()
+			return NewError(ErrCodeMoveOutput, ErrorParams{"path": out.path}, err)
+		}
+		out.committed = true
+		done = append(done, replaced{out, backup})
+	}
+
+	for _, r := range done {
+		if r.backup != "" {
+			os.Remove(r.backup)
+		}
+	}
+	if len(s.outputs) > 0 {
+		syncDir(filepath.Dir(s.outputs[0].path))
+	}
+	return nil
+}
+
+// abort discards all staged outputs that were not committed
+func (s *outputSet) abort() {
+	for _, out := range s.outputs {
+		out.abort()
+	}
+}
+
+// ValidateConflictPolicy checks that policy is one of the models.ConflictPolicy
+// values. "" is valid and means overwrite.
+func ValidateConflictPolicy(policy string) error {
+	switch policy {
+	case "", models.ConflictPolicyOverwrite, models.ConflictPolicyRename, models.ConflictPolicySkip, models.ConflictPolicyFail:
+		return nil
+	}
+	return NewError(ErrCodeConflictPolicy, ErrorParams{"policy": policy}, nil)
+}
+
+// resolveOutputPath applies a conflict policy to an output path before anything
+// is written. It returns the path to write and whether the output must be
+// skipped. reserved holds paths already claimed by other outputs of the same
+// operation, so renamed outputs don't collide with them; it may be nil.
+func resolveOutputPath(path, policy string, reserved map[string]bool) (string, bool, error) {
+	if err := ValidateConflictPolicy(policy); err != nil {
+		return "", false, err
+	}
+	if !fileExists(path) {
+		return path, false, nil
+	}
+
+	switch policy {
+	case models.ConflictPolicySkip:
+		return path, true, nil
+	case models.ConflictPolicyFail:
+		return "", false, NewError(ErrCodeOutputExists, ErrorParams{"path": path}, nil)
+	case models.ConflictPolicyRename:
+		base := strings.TrimSuffix(path, PDFExtension)
+		for n := 2; ; n++ {
+			candidate := fmt.Sprintf("%s (%d)%s", base, n, PDFExtension)
+			if !reserved[candidate] && !fileExists(candidate) {
+				return candidate, false, nil
+			}
+		}
+	default:
+		return path, false, nil
+	}
+}
+
+// fileExists reports whether anything exists at path
+func fileExists(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
+
+// syncDir flushes a directory entry so a rename survives a crash.
+// Errors are ignored: not every platform supports syncing directories.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	d.Sync()
+}
+
+// tempOutputPattern matches the temporary files created by createOutput, e.g.
+// ".report-123.pdf.tmp"; os.CreateTemp replaces the "*" with digits
+var tempOutputPattern = regexp.MustCompile(`^\..+-\d+` + regexp.QuoteMeta(PDFExtension+TempFileSuffix) + `$`)
+
+// RemoveStaleTempFiles removes temporary output files created by createOutput
+// (".<name>-*.pdf.tmp") in dir that were last modified more than olderThan ago,
+// i.e. left behind by an operation that crashed or was killed. Other files are
+// never touched. It returns the paths of the removed files.
+func RemoveStaleTempFiles(dir string, olderThan time.Duration) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	var removed []string
+	for _, entry := range entries {
+		if entry.IsDir() || !tempOutputPattern.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if err := os.Remove(path); err == nil {
+			removed = append(removed, path)
+		}
+	}
+	return removed, nil
+}
+
+// backupOutputPattern matches the backups made by outputSet.commit, e.g.
+// ".report-123.pdf.bak" for "report.pdf"; the group is the target's name
+var backupOutputPattern = regexp.MustCompile(`^\.(.+)-\d+` + regexp.QuoteMeta(PDFExtension+BackupFileSuffix) + `$`)
+
+// RestoreBackupFiles recovers from a crash in the middle of outputSet.commit.
+// A backup (".<name>-*.pdf.bak") whose target file is missing holds the
+// original file, which was moved aside but not replaced, so it is renamed back.
+// Backups whose target exists were already replaced and are removed. It
+// returns the paths of the restored files. Backups only exist while a commit
+// runs, so it must not run while operations write to dir.
+func RestoreBackupFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored []string
+	for _, entry := range entries {
+		match := backupOutputPattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		backup := filepath.Join(dir, entry.Name())
+		target := filepath.Join(dir, match[1]+PDFExtension)
+		if fileExists(target) {
+			os.Remove(backup)
+			continue
+		}
+		if err := os.Rename(backup, target); err == nil {
+			restored = append(restored, target)
+		}
+	}
+	if len(restored) > 0 {
+		syncDir(dir)
+	}
+	return restored, nil
+}
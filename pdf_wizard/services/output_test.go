@@ -0,0 +1,567 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+
+	"pdf_wizard/models"
+)
+
+// listDir returns the names of the entries in dir
+func listDir(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read directory: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestWriteOutput_ReplacesExistingFile(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	path := filepath.Join(testDir, "out.pdf")
+	if err := os.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	err := writeOutput(path, func(w io.Writer) error {
+		_, err := w.Write([]byte("new"))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("writeOutput failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "new" {
+		t.Errorf("Expected new content, got %q (%v)", data, err)
+	}
+	if names := listDir(t, testDir); len(names) != 1 {
+		t.Errorf("Expected only the output file, found %v", names)
+	}
+}
+
+func TestWriteOutput_FailureKeepsExistingFile(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	path := filepath.Join(testDir, "out.pdf")
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	writeErr := errors.New("write failed")
+	err := writeOutput(path, func(w io.Writer) error {
+		w.Write([]byte("partial"))
+		return writeErr
+	})
+	if !errors.Is(err, writeErr) {
+		t.Fatalf("Expected write error, got %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "old" {
+		t.Errorf("Expected existing content to be kept, got %q (%v)", data, err)
+	}
+	if names := listDir(t, testDir); len(names) != 1 {
+		t.Errorf("Expected temporary file to be removed, found %v", names)
+	}
+}
+
+func TestCreateOutput_TempFileInTargetDirectory(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	out, err := createOutput(filepath.Join(testDir, "report.pdf"))
+	if err != nil {
+		t.Fatalf("createOutput failed: %v", err)
+	}
+	defer out.abort()
+
+	if filepath.Dir(out.tempPath) != testDir {
+		t.Errorf("Expected temporary file in %s, got %s", testDir, out.tempPath)
+	}
+	if filepath.Ext(out.tempPath) != TempFileSuffix || filepath.Ext(out.tempPath[:len(out.tempPath)-len(TempFileSuffix)]) != PDFExtension {
+		t.Errorf("Expected a *.pdf.tmp name, got %s", out.tempPath)
+	}
+	if !tempOutputPattern.MatchString(filepath.Base(out.tempPath)) {
+		t.Errorf("Expected RemoveStaleTempFiles to recognize %s", out.tempPath)
+	}
+
+	_, err = createOutput(filepath.Join(testDir, "missing", "report.pdf"))
+	if ErrorCodeOf(err) != ErrCodeCreateOutput {
+		t.Errorf("Expected %s, got %v", ErrCodeCreateOutput, err)
+	}
+}
+
+func TestRemoveStaleTempFiles(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	old := time.Now().Add(-2 * time.Hour)
+	// Only the hidden temporary files created by createOutput are removed
+	files := map[string]time.Time{
+		".report-123.pdf.tmp":       old,
+		".my-report-4567.pdf.tmp":   old,
+		".recent-89.pdf.tmp":        time.Now(),
+		"stale.pdf.tmp":             old,
+		".notes.pdf.tmp":            old,
+		".draft-v2.pdf.tmp":         old,
+		"STALE-UPPER.PDF.TMP":       old,
+		"document.pdf":              old,
+		"notes.tmp":                 old,
+		".report-123.pdf.tmp.extra": old,
+	}
+	for name, modTime := range files {
+		path := filepath.Join(testDir, name)
+		if err := os.WriteFile(path, []byte("x"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatalf("Failed to set time of %s: %v", name, err)
+		}
+	}
+
+	removed, err := RemoveStaleTempFiles(testDir, time.Hour)
+	if err != nil {
+		t.Fatalf("RemoveStaleTempFiles failed: %v", err)
+	}
+	if len(removed) != 2 {
+		t.Errorf("Expected 2 removed files, got %v", removed)
+	}
+	for _, name := range []string{".report-123.pdf.tmp", ".my-report-4567.pdf.tmp"} {
+		if _, err := os.Stat(filepath.Join(testDir, name)); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be removed", name)
+		}
+	}
+	for _, name := range []string{".recent-89.pdf.tmp", "stale.pdf.tmp", ".notes.pdf.tmp", ".draft-v2.pdf.tmp", "STALE-UPPER.PDF.TMP", "document.pdf", "notes.tmp", ".report-123.pdf.tmp.extra"} {
+		if _, err := os.Stat(filepath.Join(testDir, name)); err != nil {
+			t.Errorf("Expected %s to be kept: %v", name, err)
+		}
+	}
+
+	if _, err := RemoveStaleTempFiles(filepath.Join(testDir, "missing"), time.Hour); err == nil {
+		t.Error("Expected error for missing directory")
+	}
+}
+
+func TestRestoreBackupFiles(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	// A crash during commit left report.pdf moved aside and summary.pdf
+	// replaced but its backup not yet removed
+	files := map[string]string{
+		".report-123.pdf.bak":  "original report",
+		".summary-456.pdf.bak": "old summary",
+		"summary.pdf":          "new summary",
+		"notes.pdf.bak":        "not ours",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(testDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	restored, err := RestoreBackupFiles(testDir)
+	if err != nil {
+		t.Fatalf("RestoreBackupFiles failed: %v", err)
+	}
+	if want := []string{filepath.Join(testDir, "report.pdf")}; !reflect.DeepEqual(restored, want) {
+		t.Errorf("Expected restored %v, got %v", want, restored)
+	}
+	for name, content := range map[string]string{"report.pdf": "original report", "summary.pdf": "new summary", "notes.pdf.bak": "not ours"} {
+		if data, err := os.ReadFile(filepath.Join(testDir, name)); err != nil || string(data) != content {
+			t.Errorf("Expected %s to hold %q, got %q (%v)", name, content, data, err)
+		}
+	}
+	if names := listDir(t, testDir); len(names) != 3 {
+		t.Errorf("Expected the backups to be gone, found %v", names)
+	}
+
+	if _, err := RestoreBackupFiles(filepath.Join(testDir, "missing")); err == nil {
+		t.Error("Expected error for missing directory")
+	}
+}
+
+func TestOutputSet_BackupPathIsRestorable(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	out, err := createOutput(filepath.Join(testDir, "report (2).pdf"))
+	if err != nil {
+		t.Fatalf("createOutput failed: %v", err)
+	}
+	defer out.abort()
+
+	match := backupOutputPattern.FindStringSubmatch(filepath.Base(out.backupPath()))
+	if match == nil || match[1]+PDFExtension != "report (2).pdf" {
+		t.Errorf("Expected %s to be restored to report (2).pdf, got %v", out.backupPath(), match)
+	}
+}
+
+func TestPDFService_MergePDFs_CancelledKeepsExistingOutput(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf1 := filepath.Join(testDir, "test1.pdf")
+	pdf2 := filepath.Join(testDir, "test2.pdf")
+	if err := createTestPDF(pdf1); err != nil {
+		t.Fatalf("Failed to create test PDF 1: %v", err)
+	}
+	if err := createTestPDF(pdf2); err != nil {
+		t.Fatalf("Failed to create test PDF 2: %v", err)
+	}
+	outputPath := filepath.Join(testDir, "merged.pdf")
+	if err := os.WriteFile(outputPath, []byte("previous"), 0644); err != nil {
+		t.Fatalf("Failed to write existing output: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reporter := ProgressReporterFunc(func(event models.ProgressEvent) {
+		if event.Phase == PhaseProcessing {
+			cancel()
+		}
+	})
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), reporter)
+
+	_, err := service.MergePDFs(ctx, []string{pdf1, pdf2, pdf1}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil || string(data) != "previous" {
+		t.Errorf("Expected existing output to be kept, got %q (%v)", data, err)
+	}
+	if names := listDir(t, testDir); len(names) != 3 {
+		t.Errorf("Expected no temporary files, found %v", names)
+	}
+}
+
+func TestPDFService_ApplyWatermark_ReplacesExistingOutput(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createTestPDF(inputPDF); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	outputPath := filepath.Join(testDir, "watermarked.pdf")
+	if err := os.WriteFile(outputPath, []byte("previous"), 0644); err != nil {
+		t.Fatalf("Failed to write existing output: %v", err)
+	}
+
+	watermark := models.WatermarkDefinition{
+		TextConfig: models.TextWatermarkConfig{Text: "DRAFT", FontFamily: "Helvetica", FontSize: 24, Opacity: 0.5, FontColor: "#FF0000", Position: "center"},
+		PageRange:  "all",
+	}
+	if _, err := service.ApplyWatermark(context.Background(), inputPDF, watermark, testDir, "watermarked", models.OutputOptions{}); err != nil {
+		t.Fatalf("ApplyWatermark failed: %v", err)
+	}
+
+	count, err := NewFileService(&FakeDialogProvider{}).GetPDFPageCount(outputPath)
+	if err != nil || count != 1 {
+		t.Errorf("Expected watermarked 1-page PDF, got %d pages (%v)", count, err)
+	}
+	if names := listDir(t, testDir); len(names) != 2 {
+		t.Errorf("Expected no temporary files, found %v", names)
+	}
+}
+
+// writeString returns a write function for outputSet.stage writing content
+func writeString(content string) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		_, err := w.Write([]byte(content))
+		return err
+	}
+}
+
+func TestOutputSet_CommitReplacesAll(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	existing := filepath.Join(testDir, "a.pdf")
+	if err := os.WriteFile(existing, []byte("old a"), 0644); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	set := &outputSet{}
+	defer set.abort()
+	for _, name := range []string{"a.pdf", "b.pdf"} {
+		if _, err := set.stage(filepath.Join(testDir, name), writeString("new "+name)); err != nil {
+			t.Fatalf("stage failed: %v", err)
+		}
+	}
+	// Nothing is visible before commit
+	if data, _ := os.ReadFile(existing); string(data) != "old a" {
+		t.Errorf("Expected existing file to be untouched before commit, got %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "b.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected b.pdf to not exist before commit")
+	}
+
+	if err := set.commit(); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	for _, name := range []string{"a.pdf", "b.pdf"} {
+		if data, _ := os.ReadFile(filepath.Join(testDir, name)); string(data) != "new "+name {
+			t.Errorf("Expected new content in %s, got %q", name, data)
+		}
+	}
+	if names := listDir(t, testDir); len(names) != 2 {
+		t.Errorf("Expected no temporary or backup files, found %v", names)
+	}
+}
+
+func TestOutputSet_CommitFailureRestoresPreviousState(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	existing := filepath.Join(testDir, "a.pdf")
+	if err := os.WriteFile(existing, []byte("old a"), 0644); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+	// A non-empty directory at c.pdf cannot be replaced by a file
+	blocked := filepath.Join(testDir, "c.pdf")
+	if err := os.MkdirAll(filepath.Join(blocked, "child"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	set := &outputSet{}
+	defer set.abort()
+	for _, name := range []string{"a.pdf", "b.pdf", "c.pdf"} {
+		if _, err := set.stage(filepath.Join(testDir, name), writeString("new "+name)); err != nil {
+			t.Fatalf("stage failed: %v", err)
+		}
+	}
+
+	err := set.commit()
+	if ErrorCodeOf(err) != ErrCodeMoveOutput {
+		t.Fatalf("Expected %s, got %v", ErrCodeMoveOutput, err)
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "old a" {
+		t.Errorf("Expected a.pdf to be restored, got %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "b.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected b.pdf to be removed")
+	}
+	if info, err := os.Stat(blocked); err != nil || !info.IsDir() {
+		t.Errorf("Expected c.pdf directory to be kept: %v", err)
+	}
+	if names := listDir(t, testDir); len(names) != 2 {
+		t.Errorf("Expected no temporary or backup files, found %v", names)
+	}
+}
+
+func TestPDFService_SplitPDF_FailureKeepsPreviousState(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 5); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+	existing := filepath.Join(testDir, "part1.pdf")
+	if err := os.WriteFile(existing, []byte("previous"), 0644); err != nil {
+		t.Fatalf("Failed to write existing output: %v", err)
+	}
+
+	// The third split cannot be written because its directory does not exist
+	splits := []models.SplitDefinition{
+		{StartPage: 1, EndPage: 1, Filename: "part1"},
+		{StartPage: 2, EndPage: 2, Filename: "part2"},
+		{StartPage: 3, EndPage: 3, Filename: filepath.Join("missing", "part3")},
+		{StartPage: 4, EndPage: 4, Filename: "part4"},
+		{StartPage: 5, EndPage: 5, Filename: "part5"},
+	}
+	_, err := service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{})
+	if ErrorCodeOf(err) != ErrCodeSplitFailed {
+		t.Fatalf("Expected %s, got %v", ErrCodeSplitFailed, err)
+	}
+	var splitErr *Error
+	if !errors.As(err, &splitErr) || splitErr.Params["index"] != 3 {
+		t.Errorf("Expected the error to name split 3, got %v", err)
+	}
+
+	if data, _ := os.ReadFile(existing); string(data) != "previous" {
+		t.Errorf("Expected part1.pdf to be untouched, got %q", data)
+	}
+	names := listDir(t, testDir)
+	if len(names) != 2 {
+		t.Errorf("Expected only input.pdf and part1.pdf, found %v", names)
+	}
+}
+
+func TestResolveOutputPath(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	existing := filepath.Join(testDir, "report.pdf")
+	for _, name := range []string{"report.pdf", "report (2).pdf"} {
+		if err := os.WriteFile(filepath.Join(testDir, name), []byte("x"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	missing := filepath.Join(testDir, "new.pdf")
+
+	tests := []struct {
+		name     string
+		path     string
+		policy   string
+		reserved map[string]bool
+		want     string
+		skip     bool
+		code     ErrorCode
+	}{
+		{"missing file, any policy", missing, models.ConflictPolicyFail, nil, missing, false, ""},
+		{"default overwrites", existing, "", nil, existing, false, ""},
+		{"overwrite", existing, models.ConflictPolicyOverwrite, nil, existing, false, ""},
+		{"rename picks next free number", existing, models.ConflictPolicyRename, nil, filepath.Join(testDir, "report (3).pdf"), false, ""},
+		{"rename avoids reserved paths", existing, models.ConflictPolicyRename, map[string]bool{filepath.Join(testDir, "report (3).pdf"): true}, filepath.Join(testDir, "report (4).pdf"), false, ""},
+		{"skip", existing, models.ConflictPolicySkip, nil, existing, true, ""},
+		{"fail", existing, models.ConflictPolicyFail, nil, "", false, ErrCodeOutputExists},
+		{"invalid policy", missing, "ask", nil, "", false, ErrCodeConflictPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, skip, err := resolveOutputPath(tt.path, tt.policy, tt.reserved)
+			if tt.code != "" {
+				if ErrorCodeOf(err) != tt.code {
+					t.Fatalf("Expected %s, got %v", tt.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveOutputPath failed: %v", err)
+			}
+			if got != tt.want || skip != tt.skip {
+				t.Errorf("Expected (%s, %v), got (%s, %v)", tt.want, tt.skip, got, skip)
+			}
+		})
+	}
+}
+
+func TestPDFService_MergePDFs_ConflictPolicies(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	input := filepath.Join(testDir, "input.pdf")
+	if err := createTestPDF(input); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	outputPath := filepath.Join(testDir, "merged.pdf")
+	if err := os.WriteFile(outputPath, []byte("previous"), 0644); err != nil {
+		t.Fatalf("Failed to write existing output: %v", err)
+	}
+	merge := func(policy string) (models.OperationResult, error) {
+		return service.MergePDFs(context.Background(), []string{input}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{ConflictPolicy: policy})
+	}
+
+	result, err := merge(models.ConflictPolicySkip)
+	if err != nil {
+		t.Fatalf("Skip failed: %v", err)
+	}
+	if len(result.Outputs) != 0 || len(result.Skipped) != 1 || result.Skipped[0] != outputPath {
+		t.Errorf("Expected skipped output, got %+v", result)
+	}
+
+	if _, err := merge(models.ConflictPolicyFail); ErrorCodeOf(err) != ErrCodeOutputExists {
+		t.Errorf("Expected %s, got %v", ErrCodeOutputExists, err)
+	}
+
+	result, err = merge(models.ConflictPolicyRename)
+	if err != nil {
+		t.Fatalf("Rename failed: %v", err)
+	}
+	renamed := filepath.Join(testDir, "merged (2).pdf")
+	if len(result.Outputs) != 1 || result.Outputs[0].Path != renamed {
+		t.Errorf("Expected output %s, got %+v", renamed, result.Outputs)
+	}
+	if data, _ := os.ReadFile(outputPath); string(data) != "previous" {
+		t.Errorf("Expected existing output to be kept, got %q", data)
+	}
+
+	if _, err := merge(models.ConflictPolicyOverwrite); err != nil {
+		t.Fatalf("Overwrite failed: %v", err)
+	}
+	if count, err := api.PageCountFile(outputPath); err != nil || count != 1 {
+		t.Errorf("Expected existing output to be replaced, got %d pages (%v)", count, err)
+	}
+}
+
+func TestPDFService_SplitPDF_ConflictPolicies(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(testDir, "part.pdf"), []byte("previous"), 0644); err != nil {
+		t.Fatalf("Failed to write existing output: %v", err)
+	}
+
+	// "part" exists, so renaming it must not take the name of the split "part (2)"
+	splits := []models.SplitDefinition{
+		{StartPage: 1, EndPage: 1, Filename: "part"},
+		{StartPage: 2, EndPage: 2, Filename: "part (2)"},
+		{StartPage: 3, EndPage: 3, Filename: "other"},
+	}
+	result, err := service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{ConflictPolicy: models.ConflictPolicyRename})
+	if err != nil {
+		t.Fatalf("SplitPDF failed: %v", err)
+	}
+	var paths []string
+	for _, output := range result.Outputs {
+		paths = append(paths, filepath.Base(output.Path))
+	}
+	expected := []string{"part (3).pdf", "part (2).pdf", "other.pdf"}
+	if fmt.Sprint(paths) != fmt.Sprint(expected) {
+		t.Errorf("Expected outputs %v, got %v", expected, paths)
+	}
+
+	result, err = service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{ConflictPolicy: models.ConflictPolicySkip})
+	if err != nil {
+		t.Fatalf("SplitPDF failed: %v", err)
+	}
+	if len(result.Outputs) != 0 || len(result.Skipped) != 3 {
+		t.Errorf("Expected all splits to be skipped, got %+v", result)
+	}
+
+	// With "fail" nothing is written if any output exists
+	if err := os.Remove(filepath.Join(testDir, "other.pdf")); err != nil {
+		t.Fatalf("Failed to remove output: %v", err)
+	}
+	_, err = service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{ConflictPolicy: models.ConflictPolicyFail})
+	if ErrorCodeOf(err) != ErrCodeOutputExists {
+		t.Errorf("Expected %s, got %v", ErrCodeOutputExists, err)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "other.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected no output to be written when failing on a conflict")
+	}
+}
@@ -0,0 +1,638 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+
+	"pdf_wizard/models"
+)
+
+// ExtractPages writes the pages selected by pageRange (e.g. "1,4,7-9,last")
+// to a single new PDF. Pages appear in the order of pageRange, and a page
+// selected more than once is included more than once.
+func (s *PDFService) ExtractPages(ctx context.Context, inputPath string, pageRange string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationExtract,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 40},
+		progressPhase{PhaseProcessing, 40},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationExtract)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Get PDF page count for validation
+	totalPages, encrypted, err := s.inspectInput(inputPath)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// The extracted pages form a new document that cannot keep the input's encryption
+	if encrypted && !options.Decrypt {
+		return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": inputPath}, nil)
+	}
+
+	spans, err := parsePageSpans(pageRange, totalPages)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidPageRange, ErrorParams{"range": pageRange}, err)
+	}
+	pages := pageNumbers(spans)
+
+	// outputFilename from frontend does not include .pdf extension
+	// Always append .pdf extension
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := s.writePages(ctx, progress, inputPath, pages, outputPath, ErrCodeExtractFailed); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := result.addOutput(outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// DeletePages writes a copy of a PDF without the pages selected by pageRange
+// (e.g. "1,3,5-last"). At least one page must remain.
+func (s *PDFService) DeletePages(ctx context.Context, inputPath string, pageRange string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationDelete,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 40},
+		progressPhase{PhaseProcessing, 40},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationDelete)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Get PDF page count for validation
+	totalPages, encrypted, err := s.inspectInput(inputPath)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// The remaining pages form a new document that cannot keep the input's encryption
+	if encrypted && !options.Decrypt {
+		return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": inputPath}, nil)
+	}
+
+	// Parse page range like WatermarkDefinition.PageRange
+	spans := []pageSpan{{1, totalPages}}
+	if pageRange = strings.TrimSpace(pageRange); pageRange != "all" {
+		spans, err = parsePageSpans(pageRange, totalPages)
+		if err != nil {
+			return models.OperationResult{}, NewError(ErrCodeInvalidPageRange, ErrorParams{"range": pageRange}, err)
+		}
+	}
+
+	// Check the expanded pages, since "all", "1-last" and "1-5" of a 5-page
+	// file all delete every page
+	deleted := make(map[int]bool)
+	for _, page := range pageNumbers(spans) {
+		deleted[page] = true
+	}
+	if len(deleted) == totalPages {
+		return models.OperationResult{}, NewError(ErrCodeDeleteAllPages, ErrorParams{"totalPages": totalPages}, nil)
+	}
+	var pages []int
+	for page := 1; page <= totalPages; page++ {
+		if !deleted[page] {
+			pages = append(pages, page)
+		}
+	}
+
+	// outputFilename from frontend does not include .pdf extension
+	// Always append .pdf extension
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := s.writePages(ctx, progress, inputPath, pages, outputPath, ErrCodeDeletePagesFailed); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := result.addOutput(outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// writePages copies pages of inputPath, in the given order, into a new
+// document at outputPath. It reports the reading, processing and writing
+// phases; a pdfcpu failure is returned as failCode.
+func (s *PDFService) writePages(ctx context.Context, progress *progressTracker, inputPath string, pages []int, outputPath string, failCode ErrorCode) error {
+	if err := checkCancelled(ctx); err != nil {
+		return err
+	}
+	progress.report(PhaseReading, 0, 1)
+
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+	config.Cmd = model.COLLECT
+	pdfCtx, err := readOptimizedContext(inputPath, config)
+	if err != nil {
+		return readError(inputPath, config.UserPW, err)
+	}
+	progress.report(PhaseReading, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return err
+	}
+	progress.report(PhaseProcessing, 0, 1)
+
+	// ExtractPages copies the pages into a new document in the given order
+	extracted, err := pdfcpu.ExtractPages(pdfCtx, pages, false)
+	if err != nil {
+		return NewError(failCode, nil, err)
+	}
+	progress.report(PhaseProcessing, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return err
+	}
+	progress.report(PhaseWriting, 0, 1)
+
+	return writeContextFile(extracted, outputPath)
+}
+
+// ReorderPages writes a copy of a PDF with its pages in a new order, given
+// as a full page sequence, as moves or as a reversal (see
+// models.ReorderDefinition).
+func (s *PDFService) ReorderPages(ctx context.Context, inputPath string, reorder models.ReorderDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationReorder,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 40},
+		progressPhase{PhaseProcessing, 40},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationReorder)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Get PDF page count for validation
+	totalPages, encrypted, err := s.inspectInput(inputPath)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// The reordered pages form a new document that cannot keep the input's encryption
+	if encrypted && !options.Decrypt {
+		return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": inputPath}, nil)
+	}
+
+	pages, err := reorderedPages(reorder, totalPages)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	unchanged := true
+	for i, page := range pages {
+		if page != i+1 {
+			unchanged = false
+			break
+		}
+	}
+	if unchanged {
+		result.warn(WarnCodeOrderUnchanged, nil)
+	}
+
+	// outputFilename from frontend does not include .pdf extension
+	// Always append .pdf extension
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := s.writePages(ctx, progress, inputPath, pages, outputPath, ErrCodeReorderFailed); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := result.addOutput(outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// reorderedPages validates reorder against totalPages and returns the new page order
+func reorderedPages(reorder models.ReorderDefinition, totalPages int) ([]int, error) {
+	modes := 0
+	if strings.TrimSpace(reorder.Sequence) != "" {
+		modes++
+	}
+	if len(reorder.Moves) > 0 {
+		modes++
+	}
+	if reorder.Reverse {
+		modes++
+	}
+	if modes != 1 {
+		return nil, NewError(ErrCodeReorderMode, nil, nil)
+	}
+
+	switch {
+	case reorder.Reverse:
+		pages := make([]int, totalPages)
+		for i := range pages {
+			pages[i] = totalPages - i
+		}
+		return pages, nil
+	case len(reorder.Moves) > 0:
+		return movedPages(reorder.Moves, totalPages)
+	}
+
+	// A full sequence must list every page exactly once
+	spans, err := parsePageSpans(reorder.Sequence, totalPages)
+	if err != nil {
+		return nil, NewError(ErrCodeInvalidPageRange, ErrorParams{"range": reorder.Sequence}, err)
+	}
+	pages := pageNumbers(spans)
+	listed := make([]bool, totalPages+1)
+	for _, page := range pages {
+		if listed[page] {
+			return nil, NewError(ErrCodeReorderDuplicatePage, ErrorParams{"page": page}, nil)
+		}
+		listed[page] = true
+	}
+	var missing []int
+	for page := 1; page <= totalPages; page++ {
+		if !listed[page] {
+			missing = append(missing, page)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, NewError(ErrCodeReorderMissingPages, ErrorParams{"pages": formatPages(missing)}, nil)
+	}
+	return pages, nil
+}
+
+// movedPages applies moves to the pages 1..totalPages in order
+func movedPages(moves []models.PageMove, totalPages int) ([]int, error) {
+	pages := make([]int, totalPages)
+	for i := range pages {
+		pages[i] = i + 1
+	}
+
+	for i, move := range moves {
+		if move.Position != models.PositionBefore && move.Position != models.PositionAfter {
+			return nil, NewError(ErrCodeMovePosition, ErrorParams{"index": i + 1, "position": move.Position}, nil)
+		}
+		spans, err := parsePageSpans(move.Pages, totalPages)
+		if err != nil {
+			return nil, NewError(ErrCodeMovePages, ErrorParams{"index": i + 1, "range": move.Pages}, err)
+		}
+		if move.Target < 1 || move.Target > totalPages {
+			return nil, NewError(ErrCodeMoveTarget, ErrorParams{"index": i + 1, "page": move.Target, "totalPages": totalPages}, nil)
+		}
+
+		// The moved pages keep the order of the range; pages listed twice move once
+		moved := make(map[int]bool)
+		var block []int
+		for _, page := range pageNumbers(spans) {
+			if !moved[page] {
+				moved[page] = true
+				block = append(block, page)
+			}
+		}
+		if moved[move.Target] {
+			return nil, NewError(ErrCodeMoveTargetMoved, ErrorParams{"index": i + 1, "page": move.Target}, nil)
+		}
+
+		var rest []int
+		insertAt := 0
+		for _, page := range pages {
+			if moved[page] {
+				continue
+			}
+			if page == move.Target {
+				insertAt = len(rest)
+				if move.Position == models.PositionAfter {
+					insertAt++
+				}
+			}
+			rest = append(rest, page)
+		}
+		reordered := make([]int, 0, totalPages)
+		reordered = append(reordered, rest[:insertAt]...)
+		reordered = append(reordered, block...)
+		pages = append(reordered, rest[insertAt:]...)
+	}
+	return pages, nil
+}
+
+// formatPages formats ascending page numbers as ranges, e.g. "4-6, 9"
+func formatPages(pages []int) string {
+	var ranges []string
+	for i := 0; i < len(pages); i++ {
+		start := pages[i]
+		for i+1 < len(pages) && pages[i+1] == pages[i]+1 {
+			i++
+		}
+		if start == pages[i] {
+			ranges = append(ranges, fmt.Sprintf("%d", start))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", start, pages[i]))
+		}
+	}
+	return strings.Join(ranges, ", ")
+}
+
+// InsertPages writes a copy of a PDF with blank pages or pages of another PDF
+// inserted before or after a page (see models.InsertDefinition). Blank pages
+// get the size of the page they are inserted next to.
+func (s *PDFService) InsertPages(ctx context.Context, inputPath string, insert models.InsertDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationInsert,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 40},
+		progressPhase{PhaseProcessing, 40},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationInsert)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Get PDF page count for validation
+	totalPages, encrypted, err := s.inspectInput(inputPath)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Exactly one of blank pages and a source PDF
+	fromSource := strings.TrimSpace(insert.SourcePath) != ""
+	if fromSource == (insert.BlankPages != 0) {
+		return models.OperationResult{}, NewError(ErrCodeInsertMode, nil, nil)
+	}
+	if insert.BlankPages < 0 {
+		return models.OperationResult{}, NewError(ErrCodeInsertBlankCount, ErrorParams{"count": insert.BlankPages}, nil)
+	}
+	if insert.Position != models.PositionBefore && insert.Position != models.PositionAfter {
+		return models.OperationResult{}, NewError(ErrCodeInsertPosition, ErrorParams{"position": insert.Position}, nil)
+	}
+	if insert.Page < 1 || insert.Page > totalPages {
+		return models.OperationResult{}, NewError(ErrCodePageOutOfRange, ErrorParams{"page": insert.Page, "totalPages": totalPages}, nil)
+	}
+
+	var sourcePages []int
+	if fromSource {
+		// Validate the source PDF and its page selection
+		if err := validatePDFFile(insert.SourcePath); err != nil {
+			return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": insert.SourcePath}, err)
+		}
+		sourceTotal, sourceEncrypted, err := s.inspectInput(insert.SourcePath)
+		if err != nil {
+			return models.OperationResult{}, err
+		}
+
+		// Pages from another PDF form a new document that cannot keep either encryption
+		if encrypted && !options.Decrypt {
+			return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": inputPath}, nil)
+		}
+		if sourceEncrypted && !options.Decrypt {
+			return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": insert.SourcePath}, nil)
+		}
+
+		spans := []pageSpan{{1, sourceTotal}}
+		if sourceRange := strings.TrimSpace(insert.SourcePages); sourceRange != "" && sourceRange != "all" {
+			spans, err = parsePageSpans(sourceRange, sourceTotal)
+			if err != nil {
+				return models.OperationResult{}, NewError(ErrCodeInvalidPageRange, ErrorParams{"range": insert.SourcePages}, err)
+			}
+		}
+		sourcePages = pageNumbers(spans)
+	}
+
+	// outputFilename from frontend does not include .pdf extension
+	// Always append .pdf extension
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if fromSource {
+		if err := s.insertSourcePages(ctx, progress, inputPath, insert, sourcePages, outputPath); err != nil {
+			return models.OperationResult{}, err
+		}
+		if err := result.addOutput(outputPath); err != nil {
+			return models.OperationResult{}, err
+		}
+		progress.done()
+		return result.finish(), nil
+	}
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseReading, 0, 1)
+
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+	config.Cmd = model.INSERTPAGESAFTER
+	if insert.Position == models.PositionBefore {
+		config.Cmd = model.INSERTPAGESBEFORE
+	}
+	pdfCtx, err := readOptimizedContext(inputPath, config)
+	if err != nil {
+		return models.OperationResult{}, readError(inputPath, config.UserPW, err)
+	}
+	progress.report(PhaseReading, 1, 1)
+
+	// Each call inserts one blank page with the MediaBox of its neighbour.
+	// Inserting before page n moves that page to n+1, so the target follows it.
+	for i := 0; i < insert.BlankPages; i++ {
+		if err := checkCancelled(ctx); err != nil {
+			return models.OperationResult{}, err
+		}
+		target := insert.Page
+		if insert.Position == models.PositionBefore {
+			target += i
+		}
+		if err := pdfCtx.InsertBlankPages(types.IntSet{target: true}, nil, insert.Position == models.PositionBefore); err != nil {
+			return models.OperationResult{}, NewError(ErrCodeInsertFailed, nil, err)
+		}
+		progress.report(PhaseProcessing, i+1, insert.BlankPages)
+	}
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseWriting, 0, 1)
+
+	// The output keeps the input's encryption unless decrypt is set
+	if options.Decrypt {
+		removeEncryption(pdfCtx)
+	}
+	if err := writeContextFile(pdfCtx, outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := result.addOutputWithConfig(outputPath, config); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// insertSourcePages writes a new document at outputPath made of the pages of
+// inputPath with sourcePages of insert.SourcePath inserted next to insert.Page.
+// It reports the reading, processing and writing phases.
+func (s *PDFService) insertSourcePages(ctx context.Context, progress *progressTracker, inputPath string, insert models.InsertDefinition, sourcePages []int, outputPath string) error {
+	if err := checkCancelled(ctx); err != nil {
+		return err
+	}
+	progress.report(PhaseReading, 0, 2)
+
+	paths := []string{inputPath, insert.SourcePath}
+	pdfCtxs := make([]*model.Context, len(paths))
+	for i, path := range paths {
+		config := s.fileService.withPassword(model.NewDefaultConfiguration(), path)
+		config.Cmd = model.COLLECT
+		pdfCtx, err := readOptimizedContext(path, config)
+		if err != nil {
+			return readError(path, config.UserPW, err)
+		}
+		pdfCtxs[i] = pdfCtx
+		progress.report(PhaseReading, i+1, len(paths))
+	}
+	input, source := pdfCtxs[0], pdfCtxs[1]
+
+	if err := checkCancelled(ctx); err != nil {
+		return err
+	}
+	progress.report(PhaseProcessing, 0, 1)
+
+	// The input pages up to split come first, then the source pages, then the rest
+	split := insert.Page
+	if insert.Position == models.PositionBefore {
+		split--
+	}
+	var head, tail []int
+	for page := 1; page <= input.PageCount; page++ {
+		if page <= split {
+			head = append(head, page)
+		} else {
+			tail = append(tail, page)
+		}
+	}
+
+	// The page size only applies to documents without pages; every page keeps its own
+	dest, err := pdfcpu.CreateContextWithXRefTable(input.Conf, types.PaperSize["A4"])
+	if err != nil {
+		return NewError(ErrCodeInsertFailed, nil, err)
+	}
+	parts := []struct {
+		from  *model.Context
+		pages []int
+	}{{input, head}, {source, sourcePages}, {input, tail}}
+	for _, part := range parts {
+		if len(part.pages) == 0 {
+			continue
+		}
+		if err := pdfcpu.AddPages(part.from, dest, part.pages, false); err != nil {
+			return NewError(ErrCodeInsertFailed, nil, err)
+		}
+	}
+	progress.report(PhaseProcessing, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return err
+	}
+	progress.report(PhaseWriting, 0, 1)
+
+	return writeContextFile(dest, outputPath)
+}
@@ -0,0 +1,482 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+
+	"pdf_wizard/models"
+)
+
+// createNumberedTestPDF creates a PDF whose page n is 100*n points wide, so
+// tests can tell pages apart after they were moved around
+func createNumberedTestPDF(path string, numPages int) error {
+	return createTitledTestPDF(path, numPages, "")
+}
+
+// createTitledTestPDF is createNumberedTestPDF with a document title in the
+// info dictionary, unless title is empty
+func createTitledTestPDF(path string, numPages int, title string) error {
+	objects := []string{"<< /Type /Catalog /Pages 2 0 R >>", ""}
+	var kids []string
+	for page := 1; page <= numPages; page++ {
+		kids = append(kids, fmt.Sprintf("%d 0 R", len(objects)+1))
+		objects = append(objects, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d 792] >>", 100*page))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), numPages)
+	trailer := fmt.Sprintf("/Size %d /Root 1 0 R", len(objects)+1)
+	if title != "" {
+		objects = append(objects, fmt.Sprintf("<< /Title (%s) >>", title))
+		trailer = fmt.Sprintf("/Size %d /Root 1 0 R /Info %d 0 R", len(objects)+1, len(objects))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< %s >>\nstartxref\n%d\n%%%%EOF", trailer, xref)
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// pageOrder returns the original page numbers of a PDF built from
+// createNumberedTestPDF, in their current order
+func pageOrder(t *testing.T, path string) []int {
+	t.Helper()
+	dims, err := api.PageDimsFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read page dimensions of %s: %v", path, err)
+	}
+	pages := make([]int, len(dims))
+	for i, dim := range dims {
+		pages[i] = int(dim.Width) / 100
+	}
+	return pages
+}
+
+func TestParsePageSpans(t *testing.T) {
+	tests := []struct {
+		pageRange string
+		want      []int
+		code      ErrorCode
+	}{
+		{"1,4,7-9,last", []int{1, 4, 7, 8, 9, 10}, ""},
+		{"last,1", []int{10, 1}, ""},
+		{"8-LAST", []int{8, 9, 10}, ""},
+		{"9-", []int{9, 10}, ""},
+		{"3,3", []int{3, 3}, ""},
+		{"", nil, ErrCodePageRangeEmpty},
+		{" , ", nil, ErrCodeNoPagesSelected},
+		{"1-2-3", nil, ErrCodePageRangeFormat},
+		{"-3", nil, ErrCodePageRangeNoStart},
+		{"first", nil, ErrCodeInvalidPageNumber},
+		{"11", nil, ErrCodePageOutOfRange},
+		{"last-3", nil, ErrCodeEndPageInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pageRange, func(t *testing.T) {
+			spans, err := parsePageSpans(tt.pageRange, 10)
+			if tt.code != "" {
+				if code := ErrorCodeOf(err); code != tt.code {
+					t.Fatalf("Expected %s, got %v", tt.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePageSpans failed: %v", err)
+			}
+			if got := pageNumbers(spans); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected pages %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPDFService_ExtractPages(t *testing.T) {
+	recorder := &progressRecorder{}
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), recorder)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 10); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	result, err := service.ExtractPages(context.Background(), inputPDF, "last,1,4,7-9,4", testDir, "extracted", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("ExtractPages failed: %v", err)
+	}
+	if result.Operation != OperationExtract || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 7 {
+		t.Fatalf("Expected one 7-page extract output, got %+v", result)
+	}
+	outputPath := filepath.Join(testDir, "extracted.pdf")
+	if want, got := []int{10, 1, 4, 7, 8, 9, 4}, pageOrder(t, outputPath); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected pages in order %v, got %v", want, got)
+	}
+	if err := api.ValidateFile(outputPath, nil); err != nil {
+		t.Errorf("Extracted PDF is invalid: %v", err)
+	}
+	assertProgressSequence(t, recorder.Events(), OperationExtract)
+}
+
+func TestPDFService_ExtractPages_Validation(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	encryptedPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(encryptedPDF, 3); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		pageRange string
+		filename  string
+		code      ErrorCode
+	}{
+		{"missing input", filepath.Join(testDir, "missing.pdf"), "1", "out", ErrCodeInvalidInput},
+		{"empty filename", inputPDF, "1", " ", ErrCodeOutputFilenameEmpty},
+		{"empty range", inputPDF, "", "out", ErrCodeInvalidPageRange},
+		{"page out of range", inputPDF, "1,4", "out", ErrCodeInvalidPageRange},
+		{"encrypted input", encryptedPDF, "1", "out", ErrCodePDFEncrypted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ExtractPages(context.Background(), tt.input, tt.pageRange, testDir, tt.filename, models.OutputOptions{})
+			if code := ErrorCodeOf(err); code != tt.code {
+				t.Errorf("Expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+
+	// A wrong page range reports the failing part as its cause
+	_, err := service.ExtractPages(context.Background(), inputPDF, "1,4", testDir, "out", models.OutputOptions{})
+	if !strings.Contains(err.Error(), "page 4 is out of range (1-3)") {
+		t.Errorf("Expected the out of range page in %q", err.Error())
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "out.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected no output for failed extractions")
+	}
+}
+
+func TestPDFService_ExtractPages_EncryptedInput(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+	if err := fileService.SetPDFPassword(inputPDF, "user"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	if _, err := service.ExtractPages(context.Background(), inputPDF, "2", testDir, "out", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodeDecryptRequired {
+		t.Fatalf("Expected %s, got %v", ErrCodeDecryptRequired, err)
+	}
+	result, err := service.ExtractPages(context.Background(), inputPDF, "3,1", testDir, "out", models.OutputOptions{Decrypt: true})
+	if err != nil {
+		t.Fatalf("ExtractPages failed: %v", err)
+	}
+	if result.Outputs[0].PageCount != 2 {
+		t.Errorf("Expected 2 pages, got %d", result.Outputs[0].PageCount)
+	}
+	if _, err := api.PageCountFile(filepath.Join(testDir, "out.pdf")); err != nil {
+		t.Errorf("Expected the extracted PDF to open without a password: %v", err)
+	}
+}
+
+func TestPDFService_DeletePages(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 6); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// Pages listed out of order or twice are deleted once, the rest keep their order
+	result, err := service.DeletePages(context.Background(), inputPDF, "last,1,3-4,3", testDir, "trimmed", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("DeletePages failed: %v", err)
+	}
+	if result.Operation != OperationDelete || len(result.Outputs) != 1 || result.Outputs[0].PageCount != 2 {
+		t.Fatalf("Expected one 2-page output, got %+v", result)
+	}
+	if want, got := []int{2, 5}, pageOrder(t, filepath.Join(testDir, "trimmed.pdf")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected remaining pages %v, got %v", want, got)
+	}
+}
+
+func TestPDFService_DeletePages_Validation(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	encryptedPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(encryptedPDF, 3); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		pageRange string
+		code      ErrorCode
+	}{
+		{"all pages", inputPDF, "1-last", ErrCodeDeleteAllPages},
+		{"all keyword", inputPDF, "all", ErrCodeDeleteAllPages},
+		{"all keyword with spaces", inputPDF, " all ", ErrCodeDeleteAllPages},
+		{"every page by number", inputPDF, "1-3", ErrCodeDeleteAllPages},
+		{"every page in pieces", inputPDF, "3,1-2,2", ErrCodeDeleteAllPages},
+		{"page out of range", inputPDF, "4", ErrCodeInvalidPageRange},
+		{"empty range", inputPDF, " ", ErrCodeInvalidPageRange},
+		{"encrypted input", encryptedPDF, "1", ErrCodePDFEncrypted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.DeletePages(context.Background(), tt.input, tt.pageRange, testDir, "out", models.OutputOptions{})
+			if code := ErrorCodeOf(err); code != tt.code {
+				t.Errorf("Expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "out.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected no output for failed deletions")
+	}
+}
+
+func TestReorderedPages(t *testing.T) {
+	tests := []struct {
+		name    string
+		reorder models.ReorderDefinition
+		want    []int
+		code    ErrorCode
+	}{
+		{"sequence", models.ReorderDefinition{Sequence: "3,1,2,4-last"}, []int{3, 1, 2, 4, 5, 6}, ""},
+		{"reverse", models.ReorderDefinition{Reverse: true}, []int{6, 5, 4, 3, 2, 1}, ""},
+		{"move before", models.ReorderDefinition{Moves: []models.PageMove{{Pages: "5-6", Position: models.PositionBefore, Target: 2}}}, []int{1, 5, 6, 2, 3, 4}, ""},
+		{"move after", models.ReorderDefinition{Moves: []models.PageMove{{Pages: "1", Position: models.PositionAfter, Target: 6}}}, []int{2, 3, 4, 5, 6, 1}, ""},
+		{"moves keep page numbers", models.ReorderDefinition{Moves: []models.PageMove{
+			{Pages: "6", Position: models.PositionBefore, Target: 1},
+			{Pages: "1,2", Position: models.PositionAfter, Target: 4},
+		}}, []int{6, 3, 4, 1, 2, 5}, ""},
+		{"no mode", models.ReorderDefinition{}, nil, ErrCodeReorderMode},
+		{"two modes", models.ReorderDefinition{Sequence: "1-6", Reverse: true}, nil, ErrCodeReorderMode},
+		{"duplicate page", models.ReorderDefinition{Sequence: "1-6,2"}, nil, ErrCodeReorderDuplicatePage},
+		{"missing pages", models.ReorderDefinition{Sequence: "1,3,6"}, nil, ErrCodeReorderMissingPages},
+		{"page out of range", models.ReorderDefinition{Sequence: "1-7"}, nil, ErrCodeInvalidPageRange},
+		{"move position", models.ReorderDefinition{Moves: []models.PageMove{{Pages: "1", Position: "behind", Target: 3}}}, nil, ErrCodeMovePosition},
+		{"move pages", models.ReorderDefinition{Moves: []models.PageMove{{Pages: "7", Position: models.PositionAfter, Target: 3}}}, nil, ErrCodeMovePages},
+		{"move target", models.ReorderDefinition{Moves: []models.PageMove{{Pages: "1", Position: models.PositionAfter, Target: 7}}}, nil, ErrCodeMoveTarget},
+		{"move target moved", models.ReorderDefinition{Moves: []models.PageMove{{Pages: "2-4", Position: models.PositionAfter, Target: 3}}}, nil, ErrCodeMoveTargetMoved},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages, err := reorderedPages(tt.reorder, 6)
+			if tt.code != "" {
+				if code := ErrorCodeOf(err); code != tt.code {
+					t.Fatalf("Expected %s, got %v", tt.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("reorderedPages failed: %v", err)
+			}
+			if !reflect.DeepEqual(pages, tt.want) {
+				t.Errorf("Expected pages %v, got %v", tt.want, pages)
+			}
+		})
+	}
+
+	_, err := reorderedPages(models.ReorderDefinition{Sequence: "1,3,6"}, 6)
+	if !strings.Contains(err.Error(), "missing pages 2, 4-5") {
+		t.Errorf("Expected the missing pages in %q", err.Error())
+	}
+}
+
+func TestPDFService_ReorderPages(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 4); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	result, err := service.ReorderPages(context.Background(), inputPDF, models.ReorderDefinition{Sequence: "3,1,2,4"}, testDir, "reordered", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("ReorderPages failed: %v", err)
+	}
+	if result.Operation != OperationReorder || len(result.Outputs) != 1 || len(result.Warnings) != 0 {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+	if want, got := []int{3, 1, 2, 4}, pageOrder(t, filepath.Join(testDir, "reordered.pdf")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected pages in order %v, got %v", want, got)
+	}
+
+	if _, err := service.ReorderPages(context.Background(), inputPDF, models.ReorderDefinition{Reverse: true}, testDir, "reversed", models.OutputOptions{}); err != nil {
+		t.Fatalf("ReorderPages failed: %v", err)
+	}
+	if want, got := []int{4, 3, 2, 1}, pageOrder(t, filepath.Join(testDir, "reversed.pdf")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected pages in order %v, got %v", want, got)
+	}
+
+	// Moving a page next to itself keeps the order
+	same := models.ReorderDefinition{Moves: []models.PageMove{{Pages: "2", Position: models.PositionAfter, Target: 1}}}
+	result, err = service.ReorderPages(context.Background(), inputPDF, same, testDir, "same", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("ReorderPages failed: %v", err)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != string(WarnCodeOrderUnchanged) {
+		t.Errorf("Expected an unchanged order warning, got %v", result.Warnings)
+	}
+}
+
+func TestPDFService_InsertPages(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	sourcePDF := filepath.Join(testDir, "source.pdf")
+	if err := createNumberedTestPDF(sourcePDF, 9); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// Blank pages have the size of the page they are inserted next to
+	tests := []struct {
+		name   string
+		insert models.InsertDefinition
+		want   []int
+	}{
+		{"blank before", models.InsertDefinition{Page: 2, Position: models.PositionBefore, BlankPages: 2}, []int{1, 2, 2, 2, 3}},
+		{"blank after last", models.InsertDefinition{Page: 3, Position: models.PositionAfter, BlankPages: 1}, []int{1, 2, 3, 3}},
+		{"source before first", models.InsertDefinition{Page: 1, Position: models.PositionBefore, SourcePath: sourcePDF, SourcePages: "9,7"}, []int{9, 7, 1, 2, 3}},
+		{"source after", models.InsertDefinition{Page: 2, Position: models.PositionAfter, SourcePath: sourcePDF, SourcePages: "8-last"}, []int{1, 2, 8, 9, 3}},
+		{"all source pages", models.InsertDefinition{Page: 3, Position: models.PositionAfter, SourcePath: inputPDF}, []int{1, 2, 3, 1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.InsertPages(context.Background(), inputPDF, tt.insert, testDir, "inserted", models.OutputOptions{ConflictPolicy: models.ConflictPolicyOverwrite})
+			if err != nil {
+				t.Fatalf("InsertPages failed: %v", err)
+			}
+			if result.Operation != OperationInsert || len(result.Outputs) != 1 || result.Outputs[0].PageCount != len(tt.want) {
+				t.Fatalf("Expected one %d-page output, got %+v", len(tt.want), result)
+			}
+			if got := pageOrder(t, filepath.Join(testDir, "inserted.pdf")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected pages %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPDFService_InsertPages_Validation(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createNumberedTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		insert models.InsertDefinition
+		code   ErrorCode
+	}{
+		{"nothing to insert", models.InsertDefinition{Page: 1, Position: models.PositionAfter}, ErrCodeInsertMode},
+		{"blank and source", models.InsertDefinition{Page: 1, Position: models.PositionAfter, BlankPages: 1, SourcePath: inputPDF}, ErrCodeInsertMode},
+		{"negative blank pages", models.InsertDefinition{Page: 1, Position: models.PositionAfter, BlankPages: -1}, ErrCodeInsertBlankCount},
+		{"invalid position", models.InsertDefinition{Page: 1, Position: "inside", BlankPages: 1}, ErrCodeInsertPosition},
+		{"page out of range", models.InsertDefinition{Page: 4, Position: models.PositionAfter, BlankPages: 1}, ErrCodePageOutOfRange},
+		{"missing source", models.InsertDefinition{Page: 1, Position: models.PositionAfter, SourcePath: filepath.Join(testDir, "missing.pdf")}, ErrCodeInvalidInput},
+		{"source range out of range", models.InsertDefinition{Page: 1, Position: models.PositionAfter, SourcePath: inputPDF, SourcePages: "2-5"}, ErrCodeInvalidPageRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.InsertPages(context.Background(), inputPDF, tt.insert, testDir, "out", models.OutputOptions{})
+			if code := ErrorCodeOf(err); code != tt.code {
+				t.Errorf("Expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "out.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected no output for failed insertions")
+	}
+}
+
+func TestPDFService_InsertPages_EncryptedInput(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+	if err := fileService.SetPDFPassword(inputPDF, "user"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	// Blank pages keep the input's encryption
+	blank := models.InsertDefinition{Page: 1, Position: models.PositionAfter, BlankPages: 1}
+	result, err := service.InsertPages(context.Background(), inputPDF, blank, testDir, "blank", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("InsertPages failed: %v", err)
+	}
+	if result.Outputs[0].PageCount != 3 {
+		t.Errorf("Expected 3 pages, got %d", result.Outputs[0].PageCount)
+	}
+	if _, err := api.PageCountFile(filepath.Join(testDir, "blank.pdf")); err == nil {
+		t.Error("Expected the output to stay encrypted")
+	}
+
+	// Pages from another PDF form a new document and require decrypt
+	fromSource := models.InsertDefinition{Page: 1, Position: models.PositionAfter, SourcePath: inputPDF, SourcePages: "2"}
+	if _, err := service.InsertPages(context.Background(), inputPDF, fromSource, testDir, "copied", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodeDecryptRequired {
+		t.Fatalf("Expected %s, got %v", ErrCodeDecryptRequired, err)
+	}
+	if _, err := service.InsertPages(context.Background(), inputPDF, fromSource, testDir, "copied", models.OutputOptions{Decrypt: true}); err != nil {
+		t.Fatalf("InsertPages failed: %v", err)
+	}
+	if count, err := api.PageCountFile(filepath.Join(testDir, "copied.pdf")); err != nil || count != 3 {
+		t.Errorf("Expected an unencrypted 3-page PDF, got %d pages (%v)", count, err)
+	}
+}
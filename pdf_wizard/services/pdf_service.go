@@ -1,12 +1,16 @@
 package services
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
 	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/color"
 	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
 	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
@@ -14,265 +18,489 @@ import (
 	"pdf_wizard/models"
 )
 
-// PDFService handles PDF operations (merge, split)
+// PDFService handles PDF operations (merge, collate, split, extract, delete, reorder, insert, rotate, watermark, encrypt, ...)
 type PDFService struct {
 	fileService *FileService
+	progress    ProgressReporter
 }
 
-// NewPDFService creates a new PDFService instance
-func NewPDFService(fileService *FileService) *PDFService {
-	return &PDFService{fileService: fileService}
+// NewPDFService creates a new PDFService instance.
+// progress receives progress events for every operation and may be nil.
+func NewPDFService(fileService *FileService, progress ProgressReporter) *PDFService {
+	return &PDFService{fileService: fileService, progress: progress}
 }
 
-// MergePDFs merges the given PDF files in order and saves to output directory
-func (s *PDFService) MergePDFs(inputPaths []string, outputDirectory string, outputFilename string) error {
+// startOperation creates a progress tracker for a new run of operation.
+// The operation ID is taken from ctx (see WithOperationID) or generated.
+func (s *PDFService) startOperation(ctx context.Context, operation string, phases ...progressPhase) *progressTracker {
+	operationID := OperationIDFromContext(ctx)
+	if operationID == "" {
+		operationID = NewOperationID()
+	}
+	return newProgressTracker(s.progress, operationID, operation, phases...)
+}
+
+// MergePDFs merges the given PDF files in order and saves to output directory.
+// Cancelling ctx stops the merge between input files. merge controls the
+// bookmarks, blank pages and optimization of the merged document. An existing
+// output file is handled according to options.ConflictPolicy.
+func (s *PDFService) MergePDFs(ctx context.Context, inputPaths []string, outputDirectory string, outputFilename string, merge models.MergeOptions, options models.OutputOptions) (models.OperationResult, error) {
+	inputs := make([]models.MergeInput, len(inputPaths))
+	for i, path := range inputPaths {
+		inputs[i] = models.MergeInput{Path: path}
+	}
+	return s.MergePDFPages(ctx, inputs, outputDirectory, outputFilename, merge, options)
+}
+
+// MergePDFPages merges the selected pages of each input in order, like
+// MergePDFs. Each input's page range is validated against its page count.
+func (s *PDFService) MergePDFPages(ctx context.Context, inputs []models.MergeInput, outputDirectory string, outputFilename string, merge models.MergeOptions, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationMerge,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 30},
+		progressPhase{PhaseProcessing, 50},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationMerge)
+
 	// Validate input files
-	if len(inputPaths) == 0 {
-		return fmt.Errorf("no input files provided")
+	if len(inputs) == 0 {
+		return models.OperationResult{}, NewError(ErrCodeNoInputFiles, nil, nil)
+	}
+	inputPaths := make([]string, len(inputs))
+	for i, input := range inputs {
+		inputPaths[i] = input.Path
 	}
 
 	// Validate all input files exist and are readable
+	progress.report(PhaseValidating, 0, len(inputPaths))
 	for i, path := range inputPaths {
 		if path == "" {
-			return fmt.Errorf("empty file path at index %d", i)
+			return models.OperationResult{}, NewError(ErrCodeEmptyInputPath, ErrorParams{"index": i}, nil)
 		}
 		if err := validatePDFFile(path); err != nil {
-			return fmt.Errorf("input file %d: %w", i+1, err)
+			return models.OperationResult{}, NewError(ErrCodeInvalidInputAt, ErrorParams{"index": i + 1}, err)
+		}
+		progress.report(PhaseValidating, i+1, len(inputPaths))
+	}
+
+	// Merging the same pages of a file twice is allowed but usually a mistake
+	seenInputs := make(map[models.MergeInput]bool)
+	for _, input := range inputs {
+		if seenInputs[input] {
+			result.warn(WarnCodeDuplicateInput, ErrorParams{"filename": filepath.Base(input.Path)})
 		}
+		seenInputs[input] = true
 	}
 
 	// Validate output directory exists and is writable
 	if err := validateOutputDirectory(outputDirectory); err != nil {
-		return err
+		return models.OperationResult{}, err
+	}
+
+	// Validate bookmark titles
+	switch merge.Bookmarks {
+	case models.BookmarksNone, models.BookmarksFilename, models.BookmarksTitle:
+	default:
+		return models.OperationResult{}, NewError(ErrCodeBookmarksInvalid, ErrorParams{"value": merge.Bookmarks}, nil)
 	}
 
 	// outputFilename from frontend does not include .pdf extension
 	// Always append .pdf extension
-	outputPath := filepath.Join(outputDirectory, outputFilename+PDFExtension)
-
-	// Remove existing output file if it exists (pdfcpu may have issues overwriting)
-	if err := removeIfExists(outputPath); err != nil {
-		return err
+	// An existing file at outputPath is handled by the conflict policy and
+	// only replaced once the merge succeeds
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
 	}
 
 	// Validate each PDF can be read before attempting merge
 	// This helps identify which PDF has issues (e.g., invalid font encoding)
+	// pages[i] holds the selected pages of input i; nil merges the whole document
+	pages := make([][]int, len(inputs))
 	for i, path := range inputPaths {
-		_, err := api.ReadContextFile(path)
+		if err := checkCancelled(ctx); err != nil {
+			return models.OperationResult{}, err
+		}
+		pdfCtx, err := s.fileService.readContext(path)
 		if err != nil {
+			if isPasswordError(err) {
+				return models.OperationResult{}, err
+			}
 			// Extract filename for better error message
 			filename := filepath.Base(path)
-			return fmt.Errorf("PDF file %d (%s) has issues and cannot be processed: %w. This file may have invalid font encoding or be corrupted. Please try repairing the PDF or use a different file", i+1, filename, err)
+			return models.OperationResult{}, NewError(ErrCodeUnreadableInput, ErrorParams{"index": i + 1, "filename": filename}, err)
+		}
+		// The merged file is a new document that cannot keep the inputs' encryption
+		if pdfCtx.Encrypt != nil && !options.Decrypt {
+			return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": path}, nil)
 		}
+		// Parse page range like WatermarkDefinition.PageRange
+		if pageRange := strings.TrimSpace(inputs[i].Pages); pageRange != "" && pageRange != "all" {
+			spans, err := parsePageSpans(pageRange, pdfCtx.PageCount)
+			if err != nil {
+				return models.OperationResult{}, NewError(ErrCodeMergePageRange, ErrorParams{"index": i + 1, "filename": filepath.Base(path), "range": inputs[i].Pages}, err)
+			}
+			pages[i] = pageNumbers(spans)
+		}
+		progress.report(PhaseReading, i+1, len(inputPaths))
 	}
 
 	// Use pdfcpu to merge PDFs
 	config := model.NewDefaultConfiguration()
 	// Merge the PDF files
-	// dividerPage: false means no divider pages between merged PDFs
-	err := api.MergeCreateFile(inputPaths, outputPath, false, config)
+	sizeBefore, err := s.mergeFiles(ctx, inputPaths, pages, outputPath, merge, config, func(merged int) {
+		if merged < len(inputPaths) {
+			progress.report(PhaseProcessing, merged, len(inputPaths))
+		} else {
+			progress.report(PhaseWriting, 0, 1)
+		}
+	})
 	if err != nil {
+		if ctx.Err() != nil {
+			return models.OperationResult{}, err
+		}
 		// Provide more helpful error message for font encoding issues
 		if strings.Contains(err.Error(), "validateFontEncoding") || strings.Contains(err.Error(), "Encoding") {
-			return fmt.Errorf("failed to merge PDFs due to font encoding issues: %w. One or more PDFs may have invalid font encoding (e.g., NULL encoding). Please try repairing the problematic PDF(s) before merging", err)
+			return models.OperationResult{}, NewError(ErrCodeMergeFontEncoding, nil, err)
 		}
-		return fmt.Errorf("failed to merge PDFs: %w", err)
+		return models.OperationResult{}, NewError(ErrCodeMergeFailed, nil, err)
 	}
 
 	// Validate the merged file was created
 	if _, err := os.Stat(outputPath); os.IsNotExist(err) {
-		return fmt.Errorf("merged file was not created at: %s", outputPath)
+		return models.OperationResult{}, NewError(ErrCodeOutputNotCreated, ErrorParams{"path": outputPath}, nil)
+	}
+	if err := result.addOutput(outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+	if merge.Optimize {
+		result.reportSizes(sizeBefore)
 	}
 
-	return nil
+	progress.done()
+	return result.finish(), nil
+}
+
+// SplitPDF splits the given PDF according to split definitions.
+// The split is all-or-nothing: if any split fails or ctx is cancelled, no
+// output is written and existing files are left untouched. The conflict policy
+// in options applies to each split's file separately.
+func (s *PDFService) SplitPDF(ctx context.Context, inputPath string, splits []models.SplitDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationSplit,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseWriting, 90},
+	)
+	return s.splitPDF(ctx, progress, inputPath, splits, outputDirectory, options)
 }
 
-// SplitPDF splits the given PDF according to split definitions
-func (s *PDFService) SplitPDF(inputPath string, splits []models.SplitDefinition, outputDirectory string) error {
+// splitPDF runs SplitPDF, reporting its validating and writing phases to progress
+func (s *PDFService) splitPDF(ctx context.Context, progress *progressTracker, inputPath string, splits []models.SplitDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	result := newResultBuilder(progress.operationID, OperationSplit)
+	progress.report(PhaseValidating, 0, 1)
+
 	// Validate input file exists and is a PDF
 	if err := validatePDFFile(inputPath); err != nil {
-		return fmt.Errorf("input file: %w", err)
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
 	}
 
 	// Validate output directory exists and is writable
 	if err := validateOutputDirectory(outputDirectory); err != nil {
-		return err
+		return models.OperationResult{}, err
 	}
 
 	// Get PDF page count for validation
-	totalPages, err := s.fileService.GetPDFPageCount(inputPath)
+	totalPages, encrypted, err := s.inspectInput(inputPath)
 	if err != nil {
-		return fmt.Errorf("failed to get page count: %w", err)
+		return models.OperationResult{}, err
+	}
+
+	// Split files are new documents that cannot keep the input's encryption
+	if encrypted && !options.Decrypt {
+		return models.OperationResult{}, NewError(ErrCodeDecryptRequired, ErrorParams{"path": inputPath}, nil)
 	}
 
 	// Validate all splits
 	for i, split := range splits {
 		if split.StartPage < 1 || split.StartPage > totalPages {
-			return fmt.Errorf("split %d: start page %d is out of range (1-%d)", i+1, split.StartPage, totalPages)
+			return models.OperationResult{}, NewError(ErrCodeSplitStartPage, ErrorParams{"index": i + 1, "page": split.StartPage, "totalPages": totalPages}, nil)
 		}
 		if split.EndPage < split.StartPage || split.EndPage > totalPages {
-			return fmt.Errorf("split %d: end page %d is invalid (must be >= start page and <= %d)", i+1, split.EndPage, totalPages)
+			return models.OperationResult{}, NewError(ErrCodeSplitEndPage, ErrorParams{"index": i + 1, "page": split.EndPage, "totalPages": totalPages}, nil)
 		}
 		if strings.TrimSpace(split.Filename) == "" {
-			return fmt.Errorf("split %d: filename cannot be empty", i+1)
+			return models.OperationResult{}, NewError(ErrCodeSplitFilenameEmpty, ErrorParams{"index": i + 1}, nil)
 		}
 	}
 
-	// Check for duplicate filenames
+	// Check for duplicate filenames, ignoring case since "Intro.pdf" and
+	// "intro.pdf" are the same file on Windows and macOS
 	filenameMap := make(map[string]bool)
 	for _, split := range splits {
 		filename := strings.TrimSpace(split.Filename) + PDFExtension
-		if filenameMap[filename] {
-			return fmt.Errorf("duplicate filename: %s", filename)
+		if filenameMap[strings.ToLower(filename)] {
+			return models.OperationResult{}, NewError(ErrCodeDuplicateFilename, ErrorParams{"filename": filename}, nil)
+		}
+		filenameMap[strings.ToLower(filename)] = true
+	}
+
+	if uncovered := uncoveredPages(splits, totalPages); len(uncovered) > 0 {
+		result.warn(WarnCodePagesNotInSplit, ErrorParams{"pages": strings.Join(uncovered, ", ")})
+	}
+
+	// Apply the conflict policy to every output before anything is written.
+	// Renamed outputs must not take the name of another split.
+	outputPaths := make([]string, len(splits)) // "" for skipped splits
+	reserved := make(map[string]bool)
+	for i, split := range splits {
+		outputPaths[i] = filepath.Join(outputDirectory, strings.TrimSpace(split.Filename)+PDFExtension)
+		reserved[outputPaths[i]] = true
+	}
+	for i := range splits {
+		outputPath, skip, err := resolveOutputPath(outputPaths[i], options.ConflictPolicy, reserved)
+		if err != nil {
+			return models.OperationResult{}, err
+		}
+		if skip {
+			result.skip(outputPath)
+			outputPaths[i] = ""
+			continue
 		}
-		filenameMap[filename] = true
+		reserved[outputPath] = true
+		outputPaths[i] = outputPath
 	}
 
+	progress.report(PhaseValidating, 1, 1)
+
 	// Use pdfcpu to split the PDF
-	config := model.NewDefaultConfiguration()
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodePDFRead, nil, err)
+	}
+	defer input.Close()
+
+	// Stage every split in a temporary file first; the outputs only replace
+	// existing files once all splits succeeded
+	staged := &outputSet{}
+	defer staged.abort()
 
 	// Process each split
 	for i, split := range splits {
-		// Create output path
-		outputPath := filepath.Join(outputDirectory, strings.TrimSpace(split.Filename)+PDFExtension)
+		if err := checkCancelled(ctx); err != nil {
+			return models.OperationResult{}, err
+		}
 
-		// Remove existing output file if it exists
-		if err := removeIfExists(outputPath); err != nil {
-			return err
+		outputPath := outputPaths[i]
+		if outputPath == "" {
+			progress.report(PhaseWriting, i+1, len(splits))
+			continue
 		}
 
-		// Use TrimFile to extract the page range
-		// pdfcpu uses 1-based page numbers and TrimFile keeps only the specified pages
+		// Use Trim to extract the page range
+		// pdfcpu uses 1-based page numbers and Trim keeps only the specified pages
 		pageRange := fmt.Sprintf("%d-%d", split.StartPage, split.EndPage)
-		err := api.TrimFile(inputPath, outputPath, []string{pageRange}, config)
+		out, err := staged.stage(outputPath, func(w io.Writer) error {
+			return api.Trim(input, w, []string{pageRange}, config)
+		})
 		if err != nil {
-			return fmt.Errorf("failed to trim pages for split %d (pages %d-%d): %w", i+1, split.StartPage, split.EndPage, err)
+			return models.OperationResult{}, NewError(ErrCodeSplitFailed, ErrorParams{"index": i + 1, "startPage": split.StartPage, "endPage": split.EndPage}, err)
 		}
-
-		// Validate the split file was created
-		if _, err := os.Stat(outputPath); os.IsNotExist(err) {
-			return fmt.Errorf("split file was not created at: %s", outputPath)
+		if err := result.addStagedOutput(out); err != nil {
+			return models.OperationResult{}, NewError(ErrCodeSplitFailed, ErrorParams{"index": i + 1, "startPage": split.StartPage, "endPage": split.EndPage}, err)
 		}
+		progress.report(PhaseWriting, i+1, len(splits))
 	}
 
-	return nil
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	if err := staged.commit(); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
 }
 
-// RotatePDF rotates specified page ranges in a PDF file
-func (s *PDFService) RotatePDF(inputPath string, rotations []models.RotateDefinition, outputDirectory string, outputFilename string) error {
+// RotatePDF rotates specified page ranges in a PDF file.
+// Cancelling ctx stops between rotation passes and removes the temporary file.
+func (s *PDFService) RotatePDF(ctx context.Context, inputPath string, rotations []models.RotateDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationRotate,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseProcessing, 80},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationRotate)
+	progress.report(PhaseValidating, 0, 1)
+
 	// Validate input file exists and is a PDF
 	if err := validatePDFFile(inputPath); err != nil {
-		return fmt.Errorf("input file: %w", err)
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
 	}
 
 	// Validate output directory exists and is writable
 	if err := validateOutputDirectory(outputDirectory); err != nil {
-		return err
+		return models.OperationResult{}, err
 	}
 
 	// Validate output filename
 	if strings.TrimSpace(outputFilename) == "" {
-		return fmt.Errorf("output filename cannot be empty")
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
 	}
 
 	// Get PDF page count for validation
-	totalPages, err := s.fileService.GetPDFPageCount(inputPath)
+	totalPages, _, err := s.inspectInput(inputPath)
 	if err != nil {
-		return fmt.Errorf("failed to get page count: %w", err)
+		return models.OperationResult{}, err
 	}
 
 	// Validate all rotations
 	for i, rotation := range rotations {
 		if rotation.StartPage < 1 || rotation.StartPage > totalPages {
-			return fmt.Errorf("rotation %d: start page %d is out of range (1-%d)", i+1, rotation.StartPage, totalPages)
+			return models.OperationResult{}, NewError(ErrCodeRotationStartPage, ErrorParams{"index": i + 1, "page": rotation.StartPage, "totalPages": totalPages}, nil)
 		}
 		if rotation.EndPage < rotation.StartPage || rotation.EndPage > totalPages {
-			return fmt.Errorf("rotation %d: end page %d is invalid (must be >= start page and <= %d)", i+1, rotation.EndPage, totalPages)
+			return models.OperationResult{}, NewError(ErrCodeRotationEndPage, ErrorParams{"index": i + 1, "page": rotation.EndPage, "totalPages": totalPages}, nil)
 		}
 		// Validate rotation angle: 90, -90, or 180
 		if rotation.Rotation != 90 && rotation.Rotation != -90 && rotation.Rotation != 180 {
-			return fmt.Errorf("rotation %d: invalid rotation angle %d (must be 90, -90, or 180)", i+1, rotation.Rotation)
+			return models.OperationResult{}, NewError(ErrCodeRotationAngle, ErrorParams{"index": i + 1, "angle": rotation.Rotation}, nil)
+		}
+	}
+
+	// Overlapping rotations are applied cumulatively
+	for i := range rotations {
+		for j := i + 1; j < len(rotations); j++ {
+			if rotations[i].StartPage <= rotations[j].EndPage && rotations[j].StartPage <= rotations[i].EndPage {
+				result.warn(WarnCodeRotationsOverlap, ErrorParams{"first": i + 1, "second": j + 1})
+			}
 		}
 	}
 
 	// outputFilename from frontend does not include .pdf extension
 	// Always append .pdf extension
-	outputPath := filepath.Join(outputDirectory, outputFilename+PDFExtension)
-
-	// Create a temporary copy of the input file for rotation operations
-	// pdfcpu RotatePages modifies the file in place, so we need to work with a copy
-	tempPath := outputPath + ".tmp"
-	if err := copyFile(inputPath, tempPath); err != nil {
-		return fmt.Errorf("failed to create temporary copy: %w", err)
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
 	}
-	defer os.Remove(tempPath) // Clean up temp file
+
+	progress.report(PhaseValidating, 1, 1)
 
 	// Use pdfcpu to rotate pages
-	config := model.NewDefaultConfiguration()
+	// All rotations are applied to one in-memory copy of the input, which is
+	// only written to outputPath once every rotation succeeded
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+	config.Cmd = model.ROTATE
+	pdfCtx, err := readOptimizedContext(inputPath, config)
+	if err != nil {
+		return models.OperationResult{}, readError(inputPath, config.UserPW, err)
+	}
 
 	// Process each rotation
 	for i, rotation := range rotations {
+		if err := checkCancelled(ctx); err != nil {
+			return models.OperationResult{}, err
+		}
+
 		// Build page selection string (e.g., "1-5" for pages 1 to 5)
 		pageSelection := fmt.Sprintf("%d-%d", rotation.StartPage, rotation.EndPage)
 
 		// Rotate the pages
-		// pdfcpu uses degrees, and RotateFile rotates the selected pages
-		err := api.RotateFile(tempPath, "", rotation.Rotation, []string{pageSelection}, config)
+		// pdfcpu uses degrees, and RotatePages rotates the selected pages
+		pages, err := api.PagesForPageSelection(pdfCtx.PageCount, []string{pageSelection}, true, false)
+		if err == nil {
+			err = pdfcpu.RotatePages(pdfCtx, pages, rotation.Rotation)
+		}
 		if err != nil {
-			return fmt.Errorf("failed to rotate pages for rotation %d (pages %d-%d, angle %d): %w", i+1, rotation.StartPage, rotation.EndPage, rotation.Rotation, err)
+			return models.OperationResult{}, NewError(ErrCodeRotateFailed, ErrorParams{"index": i + 1, "startPage": rotation.StartPage, "endPage": rotation.EndPage, "angle": rotation.Rotation}, err)
 		}
+		progress.report(PhaseProcessing, i+1, len(rotations))
 	}
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	progress.report(PhaseWriting, 0, 1)
 
-	// Remove existing output file if it exists
-	if err := removeIfExists(outputPath); err != nil {
-		return err
+	// The output keeps the input's encryption unless decrypt is set
+	if options.Decrypt {
+		removeEncryption(pdfCtx)
 	}
 
-	// Move the temporary file to the final output location
-	if err := os.Rename(tempPath, outputPath); err != nil {
-		return fmt.Errorf("failed to move rotated file to output location: %w", err)
+	// Write the rotated document, replacing any existing output
+	if err := writeContextFile(pdfCtx, outputPath); err != nil {
+		return models.OperationResult{}, err
 	}
 
 	// Validate the rotated file was created
 	if _, err := os.Stat(outputPath); os.IsNotExist(err) {
-		return fmt.Errorf("rotated file was not created at: %s", outputPath)
+		return models.OperationResult{}, NewError(ErrCodeOutputNotCreated, ErrorParams{"path": outputPath}, nil)
+	}
+	if err := result.addOutputWithConfig(outputPath, config); err != nil {
+		return models.OperationResult{}, err
 	}
 
-	return nil
+	progress.done()
+	return result.finish(), nil
 }
 
-// ApplyWatermark applies a text watermark to the specified PDF file
-func (s *PDFService) ApplyWatermark(inputPath string, watermark models.WatermarkDefinition, outputDirectory string, outputFilename string) error {
+// ApplyWatermark applies a text watermark to the specified PDF file.
+// Cancelling ctx before the output is written removes the temporary file.
+func (s *PDFService) ApplyWatermark(ctx context.Context, inputPath string, watermark models.WatermarkDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationWatermark,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseProcessing, 80},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationWatermark)
+	progress.report(PhaseValidating, 0, 1)
+
 	// Validate input file exists and is a PDF
 	if err := validatePDFFile(inputPath); err != nil {
-		return fmt.Errorf("input file: %w", err)
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
 	}
 
 	// Validate output directory exists and is writable
 	if err := validateOutputDirectory(outputDirectory); err != nil {
-		return err
+		return models.OperationResult{}, err
 	}
 
 	// Validate output filename
 	if strings.TrimSpace(outputFilename) == "" {
-		return fmt.Errorf("output filename cannot be empty")
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
 	}
 
 	// Validate text watermark configuration
 	if strings.TrimSpace(watermark.TextConfig.Text) == "" {
-		return fmt.Errorf("watermark text cannot be empty")
+		return models.OperationResult{}, NewError(ErrCodeWatermarkTextEmpty, nil, nil)
 	}
 	if watermark.TextConfig.FontSize < 1 {
-		return fmt.Errorf("font size must be at least 1")
+		return models.OperationResult{}, NewError(ErrCodeFontSize, ErrorParams{"fontSize": watermark.TextConfig.FontSize}, nil)
 	}
 	if watermark.TextConfig.Opacity < 0.0 || watermark.TextConfig.Opacity > 1.0 {
-		return fmt.Errorf("opacity must be between 0.0 and 1.0")
+		return models.OperationResult{}, NewError(ErrCodeOpacity, ErrorParams{"opacity": watermark.TextConfig.Opacity}, nil)
+	}
+	if watermark.TextConfig.Opacity == 0 {
+		result.warn(WarnCodeWatermarkInvisible, nil)
 	}
 
 	// Get PDF page count for validation
-	totalPages, err := s.fileService.GetPDFPageCount(inputPath)
+	totalPages, _, err := s.inspectInput(inputPath)
 	if err != nil {
-		return fmt.Errorf("failed to get page count: %w", err)
+		return models.OperationResult{}, err
 	}
 
 	// Parse page range
@@ -284,23 +512,27 @@ func (s *PDFService) ApplyWatermark(inputPath string, watermark models.Watermark
 		// Parse specific page range (e.g., "1,3,5-10,15")
 		pageSelection, err = parsePageRange(watermark.PageRange, totalPages)
 		if err != nil {
-			return fmt.Errorf("invalid page range: %w", err)
+			return models.OperationResult{}, NewError(ErrCodeInvalidPageRange, ErrorParams{"range": watermark.PageRange}, err)
 		}
 	}
 
 	// outputFilename from frontend does not include .pdf extension
 	// Always append .pdf extension
-	outputPath := filepath.Join(outputDirectory, outputFilename+PDFExtension)
-
-	// Create a temporary copy of the input file for watermark operations
-	tempPath := outputPath + ".tmp"
-	if err := copyFile(inputPath, tempPath); err != nil {
-		return fmt.Errorf("failed to create temporary copy: %w", err)
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
 	}
-	defer os.Remove(tempPath) // Clean up temp file
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
 
 	// Use pdfcpu to add watermark
-	config := model.NewDefaultConfiguration()
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
 
 	// Convert position to pdfcpu anchor format
 	anchor := convertPositionToAnchor(watermark.TextConfig.Position)
@@ -308,14 +540,14 @@ func (s *PDFService) ApplyWatermark(inputPath string, watermark models.Watermark
 	// Parse color from hex string
 	fillColor, err := parseColor(watermark.TextConfig.FontColor)
 	if err != nil {
-		return fmt.Errorf("invalid font color: %w", err)
+		return models.OperationResult{}, NewError(ErrCodeFontColor, ErrorParams{"color": watermark.TextConfig.FontColor}, err)
 	}
 
 	// Create watermark using pdfcpu's TextWatermark function for proper initialization
 	// This ensures all internal maps and structures are properly initialized
 	wm, err := api.TextWatermark(watermark.TextConfig.Text, "", false, false, types.POINTS)
 	if err != nil {
-		return fmt.Errorf("failed to create watermark: %w", err)
+		return models.OperationResult{}, NewError(ErrCodeWatermarkCreate, nil, err)
 	}
 
 	// Customize the watermark with user settings
@@ -335,39 +567,84 @@ func (s *PDFService) ApplyWatermark(inputPath string, watermark models.Watermark
 		wm.FillColor = adjustColorOpacity(fillColor, watermark.TextConfig.Opacity)
 	}
 
-	// Apply watermark using pdfcpu's AddWatermarksFile
-	err = api.AddWatermarksFile(tempPath, "", pageSelection, wm, config)
+	// Apply watermark using pdfcpu's AddWatermarks
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	config.Cmd = model.ADDWATERMARKS
+	config.OptimizeDuplicateContentStreams = false
+	pdfCtx, err := readOptimizedContext(inputPath, config)
 	if err != nil {
-		return fmt.Errorf("failed to apply watermark: %w", err)
+		return models.OperationResult{}, readError(inputPath, config.UserPW, err)
 	}
 
-	// Remove existing output file if it exists
-	if err := removeIfExists(outputPath); err != nil {
-		return err
+	progress.report(PhaseProcessing, 0, 1)
+	pages, err := api.PagesForPageSelection(pdfCtx.PageCount, pageSelection, true, true)
+	if err == nil {
+		err = pdfcpu.AddWatermarks(pdfCtx, pages, wm)
+	}
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodeWatermarkFailed, nil, err)
+	}
+	progress.report(PhaseProcessing, 1, 1)
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
 	}
+	progress.report(PhaseWriting, 0, 1)
 
-	// Move the temporary file to the final output location
-	if err := os.Rename(tempPath, outputPath); err != nil {
-		return fmt.Errorf("failed to move watermarked file to output location: %w", err)
+	// The output keeps the input's encryption unless decrypt is set
+	if options.Decrypt {
+		removeEncryption(pdfCtx)
+	}
+	if err := writeContextFile(pdfCtx, outputPath); err != nil {
+		return models.OperationResult{}, err
 	}
 
 	// Validate the watermarked file was created
 	if _, err := os.Stat(outputPath); os.IsNotExist(err) {
-		return fmt.Errorf("watermarked file was not created at: %s", outputPath)
+		return models.OperationResult{}, NewError(ErrCodeOutputNotCreated, ErrorParams{"path": outputPath}, nil)
+	}
+	if err := result.addOutputWithConfig(outputPath, config); err != nil {
+		return models.OperationResult{}, err
 	}
 
-	return nil
+	progress.done()
+	return result.finish(), nil
 }
 
 // parsePageRange parses a page range string like "1,3,5-10,15" into pdfcpu page selection format
 func parsePageRange(pageRange string, totalPages int) ([]string, error) {
+	spans, err := parsePageSpans(pageRange, totalPages)
+	if err != nil {
+		return nil, err
+	}
+
+	selections := make([]string, len(spans))
+	for i, span := range spans {
+		if span.start == span.end {
+			selections[i] = fmt.Sprintf("%d", span.start)
+		} else {
+			selections[i] = fmt.Sprintf("%d-%d", span.start, span.end)
+		}
+	}
+	return selections, nil
+}
+
+// pageSpan is an inclusive range of 1-based page numbers
+type pageSpan struct {
+	start, end int
+}
+
+// parsePageSpans parses a page range string like "1,3,5-10,last" into page spans,
+// keeping the order of the string. "last" stands for the last page.
+func parsePageSpans(pageRange string, totalPages int) ([]pageSpan, error) {
 	if strings.TrimSpace(pageRange) == "" {
-		return nil, fmt.Errorf("page range cannot be empty")
+		return nil, NewError(ErrCodePageRangeEmpty, nil, nil)
 	}
 
 	// Split by comma
 	parts := strings.Split(pageRange, ",")
-	var selections []string
+	var spans []pageSpan
 
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
@@ -379,7 +656,7 @@ func parsePageRange(pageRange string, totalPages int) ([]string, error) {
 		if strings.Contains(part, "-") {
 			rangeParts := strings.Split(part, "-")
 			if len(rangeParts) != 2 {
-				return nil, fmt.Errorf("invalid page range format: %s", part)
+				return nil, NewError(ErrCodePageRangeFormat, ErrorParams{"range": part}, nil)
 			}
 
 			startStr := strings.TrimSpace(rangeParts[0])
@@ -389,52 +666,98 @@ func parsePageRange(pageRange string, totalPages int) ([]string, error) {
 			var err error
 
 			if startStr == "" {
-				return nil, fmt.Errorf("invalid page range: start page is empty")
+				return nil, NewError(ErrCodePageRangeNoStart, ErrorParams{"range": part}, nil)
 			}
-			start, err = parseInt(startStr)
+			start, err = parsePageNumber(startStr, totalPages)
 			if err != nil {
-				return nil, fmt.Errorf("invalid start page in range %s: %w", part, err)
+				return nil, NewError(ErrCodeInvalidPageNumber, ErrorParams{"value": startStr, "range": part}, err)
 			}
 
 			if endStr == "" {
 				// Open-ended range (e.g., "5-")
 				end = totalPages
 			} else {
-				end, err = parseInt(endStr)
+				end, err = parsePageNumber(endStr, totalPages)
 				if err != nil {
-					return nil, fmt.Errorf("invalid end page in range %s: %w", part, err)
+					return nil, NewError(ErrCodeInvalidPageNumber, ErrorParams{"value": endStr, "range": part}, err)
 				}
 			}
 
 			// Validate range
 			if start < 1 || start > totalPages {
-				return nil, fmt.Errorf("start page %d is out of range (1-%d)", start, totalPages)
+				return nil, NewError(ErrCodePageOutOfRange, ErrorParams{"page": start, "totalPages": totalPages}, nil)
 			}
 			if end < start || end > totalPages {
-				return nil, fmt.Errorf("end page %d is invalid (must be >= start page and <= %d)", end, totalPages)
+				return nil, NewError(ErrCodeEndPageInvalid, ErrorParams{"page": end, "totalPages": totalPages}, nil)
 			}
 
-			selections = append(selections, fmt.Sprintf("%d-%d", start, end))
+			spans = append(spans, pageSpan{start, end})
 		} else {
 			// Single page
-			page, err := parseInt(part)
+			page, err := parsePageNumber(part, totalPages)
 			if err != nil {
-				return nil, fmt.Errorf("invalid page number %s: %w", part, err)
+				return nil, NewError(ErrCodeInvalidPageNumber, ErrorParams{"value": part}, err)
 			}
 
 			if page < 1 || page > totalPages {
-				return nil, fmt.Errorf("page %d is out of range (1-%d)", page, totalPages)
+				return nil, NewError(ErrCodePageOutOfRange, ErrorParams{"page": page, "totalPages": totalPages}, nil)
 			}
 
-			selections = append(selections, fmt.Sprintf("%d", page))
+			spans = append(spans, pageSpan{page, page})
 		}
 	}
 
-	if len(selections) == 0 {
-		return nil, fmt.Errorf("no valid pages in range")
+	if len(spans) == 0 {
+		return nil, NewError(ErrCodeNoPagesSelected, nil, nil)
 	}
 
-	return selections, nil
+	return spans, nil
+}
+
+// parsePageNumber parses a page number, accepting "last" for totalPages
+func parsePageNumber(s string, totalPages int) (int, error) {
+	if strings.EqualFold(s, "last") {
+		return totalPages, nil
+	}
+	return parseInt(s)
+}
+
+// pageNumbers expands page spans into page numbers, keeping their order and duplicates
+func pageNumbers(spans []pageSpan) []int {
+	var pages []int
+	for _, span := range spans {
+		for page := span.start; page <= span.end; page++ {
+			pages = append(pages, page)
+		}
+	}
+	return pages
+}
+
+// uncoveredPages returns the page ranges (e.g. "4-6") not included in any split
+func uncoveredPages(splits []models.SplitDefinition, totalPages int) []string {
+	covered := make([]bool, totalPages+1)
+	for _, split := range splits {
+		for page := split.StartPage; page <= split.EndPage && page <= totalPages; page++ {
+			covered[page] = true
+		}
+	}
+
+	var ranges []string
+	for page := 1; page <= totalPages; page++ {
+		if covered[page] {
+			continue
+		}
+		start := page
+		for page < totalPages && !covered[page+1] {
+			page++
+		}
+		if start == page {
+			ranges = append(ranges, fmt.Sprintf("%d", start))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", start, page))
+		}
+	}
+	return ranges
 }
 
 // parseInt parses a string to int
@@ -490,7 +813,7 @@ func parseColor(hexColor string) (color.SimpleColor, error) {
 
 	c, err := color.NewSimpleColorForHexCode(hexColor)
 	if err != nil {
-		return color.Black, fmt.Errorf("invalid hex color format: %w", err)
+		return color.Black, NewError(ErrCodeInvalidColor, ErrorParams{"color": hexColor}, err)
 	}
 
 	return c, nil
@@ -516,30 +839,201 @@ func adjustColorOpacity(c color.SimpleColor, opacity float64) color.SimpleColor
 	return color.SimpleColor{R: r, G: g, B: b}
 }
 
-// removeIfExists removes a file if it exists, returning an error only if removal fails
-func removeIfExists(path string) error {
-	if _, err := os.Stat(path); err == nil {
-		if err := os.Remove(path); err != nil {
-			return fmt.Errorf("failed to remove existing file: %w", err)
+// mergeFiles merges inputPaths into outputPath like api.MergeCreateFile, but
+// appends one input at a time so ctx can cancel the merge between files.
+// pages[i] selects the pages of inputPaths[i] in order; nil merges the whole
+// document. onMerged is called with the number of inputs merged so far. Inputs
+// are opened with their stored passwords (see FileService.SetPDFPassword). With
+// merge.Optimize set the merged document is optimized like OptimizePDF and
+// the size it would have without optimizing is returned (0 otherwise);
+// merge.Bookmarks and merge.NestOutlines control its outline, and
+// merge.DividerPage and merge.DuplexPadding add blank pages between inputs.
+func (s *PDFService) mergeFiles(ctx context.Context, inputPaths []string, pages [][]int, outputPath string, merge models.MergeOptions, config *model.Configuration, onMerged func(merged int)) (int64, error) {
+	config.Cmd = model.MERGECREATE
+	config.ValidationMode = model.ValidationRelaxed
+	// pdfcpu adds a bookmark per input and nests the input's outline beneath it
+	config.CreateBookmarks = merge.Bookmarks != models.BookmarksNone
+
+	ctxDest, err := s.readMergeInput(inputPaths[0], pages[0], config)
+	if err != nil {
+		return 0, err
+	}
+	if !config.CreateBookmarks || !merge.NestOutlines {
+		if err := removeOutline(ctxDest); err != nil {
+			return 0, err
 		}
 	}
-	return nil
+	if config.CreateBookmarks {
+		if err := pdfcpu.EnsureOutlines(ctxDest, bookmarkTitle(merge.Bookmarks, inputPaths[0], ctxDest.Title), false); err != nil {
+			return 0, err
+		}
+	}
+	if ctxDest.XRefTable.Version() < model.V20 {
+		ctxDest.EnsureVersionForWriting()
+	}
+	onMerged(1)
+
+	for i, path := range inputPaths[1:] {
+		if err := checkCancelled(ctx); err != nil {
+			return 0, err
+		}
+		ctxSource, err := s.readMergeInput(path, pages[i+1], config)
+		if err != nil {
+			return 0, err
+		}
+		if ctxDest.XRefTable.Version() < model.V20 && ctxSource.XRefTable.Version() == model.V20 {
+			return 0, pdfcpu.ErrUnsupportedVersion
+		}
+		if config.CreateBookmarks && !merge.NestOutlines {
+			if err := removeOutline(ctxSource); err != nil {
+				return 0, err
+			}
+		}
+		// For duplex printing every input starts on an odd page, counting the divider page
+		pagesBefore := ctxDest.PageCount
+		if merge.DividerPage {
+			pagesBefore++
+		}
+		if merge.DuplexPadding && pagesBefore%2 == 1 {
+			if err := appendBlankPage(ctxDest); err != nil {
+				return 0, err
+			}
+		}
+		if err := pdfcpu.MergeXRefTables(bookmarkTitle(merge.Bookmarks, path, ctxSource.Title), ctxSource, ctxDest, false, merge.DividerPage); err != nil {
+			return 0, err
+		}
+		onMerged(i + 2)
+	}
+
+	if err := checkCancelled(ctx); err != nil {
+		return 0, err
+	}
+	if config.OptimizeBeforeWriting {
+		if err := api.OptimizeContext(ctxDest); err != nil {
+			return 0, err
+		}
+	}
+	// Encrypted inputs are only merged with decrypt set (see MergePDFs)
+	removeEncryption(ctxDest)
+	if !merge.Optimize {
+		return 0, writeContextFile(ctxDest, outputPath)
+	}
+
+	// The merge is written in memory as it would be without optimizing, which
+	// is the size before optimizing, and read back to be optimized
+	var buf bytes.Buffer
+	if err := api.WriteContext(ctxDest, &buf); err != nil {
+		return 0, err
+	}
+	merged, err := api.ReadAndValidate(bytes.NewReader(buf.Bytes()), config)
+	if err != nil {
+		return 0, err
+	}
+	if err := optimizeContext(merged); err != nil {
+		return 0, err
+	}
+	return int64(buf.Len()), writeContextFile(merged, outputPath)
 }
 
-// copyFile copies a file from src to dst
-func copyFile(src, dst string) error {
-	sourceFile, err := os.Open(src)
+// readMergeInput reads a merge input with its stored password. With pages set
+// the returned context only holds those pages, in order.
+func (s *PDFService) readMergeInput(path string, pages []int, config *model.Configuration) (*model.Context, error) {
+	pdfCtx, err := readValidatedContext(path, s.fileService.withPassword(config, path))
+	if err != nil || pages == nil {
+		return pdfCtx, err
+	}
+	extracted, err := pdfcpu.ExtractPages(pdfCtx, pages, false)
+	if err != nil {
+		return nil, err
+	}
+	// MergeXRefTables needs the state of a context that was read from a file,
+	// so the extracted pages are written and read back in memory
+	var buf bytes.Buffer
+	if err := api.WriteContext(extracted, &buf); err != nil {
+		return nil, err
+	}
+	selected, err := api.ReadAndValidate(bytes.NewReader(buf.Bytes()), config)
 	if err != nil {
+		return nil, err
+	}
+	// The document title is used for bookmarks (see bookmarkTitle)
+	selected.Title = pdfCtx.Title
+	return selected, nil
+}
+
+// bookmarkTitle returns the title of a merged input's bookmark: the filename
+// without extension, or with models.BookmarksTitle the document title if set
+func bookmarkTitle(bookmarks string, path string, documentTitle string) string {
+	if bookmarks == models.BookmarksTitle && strings.TrimSpace(documentTitle) != "" {
+		return strings.TrimSpace(documentTitle)
+	}
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// appendBlankPage appends a blank page with the size of the last page
+func appendBlankPage(pdfCtx *model.Context) error {
+	if err := pdfCtx.InsertBlankPages(types.IntSet{pdfCtx.PageCount: true}, nil, false); err != nil {
 		return err
 	}
-	defer sourceFile.Close()
+	// InsertBlankPages updates the page tree but not the page count MergeXRefTables checks
+	pdfCtx.PageCount++
+	return nil
+}
 
-	destFile, err := os.Create(dst)
+// removeOutline removes the outline (bookmarks) of a merge input
+func removeOutline(pdfCtx *model.Context) error {
+	if _, err := pdfcpu.RemoveBookmarks(pdfCtx); err != nil {
+		return err
+	}
+	rootDict, err := pdfCtx.Catalog()
 	if err != nil {
 		return err
 	}
-	defer destFile.Close()
+	// RemoveBookmarks leaves a null entry, which MergeXRefTables would follow
+	rootDict.Delete("Outlines")
+	return nil
+}
+
+// readValidatedContext reads and validates a PDF file with the given configuration
+func readValidatedContext(path string, config *model.Configuration) (*model.Context, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return api.ReadAndValidate(f, config)
+}
 
-	_, err = destFile.ReadFrom(sourceFile)
-	return err
+// readOptimizedContext reads, validates and optimizes a PDF file for config.Cmd
+func readOptimizedContext(path string, config *model.Configuration) (*model.Context, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return api.ReadValidateAndOptimize(f, config)
+}
+
+// writeContextFile writes a pdfcpu context to path (see writeOutput)
+func writeContextFile(ctx *model.Context, path string) error {
+	return writeOutput(path, func(w io.Writer) error {
+		if err := api.WriteContext(ctx, w); err != nil {
+			return NewError(ErrCodeWriteOutput, ErrorParams{"path": path}, err)
+		}
+		return nil
+	})
+}
+
+// writeOutput atomically creates path with the content written by write.
+// If write fails the temporary file is removed and any existing file at path is kept.
+func writeOutput(path string, write func(w io.Writer) error) error {
+	out, err := createOutput(path)
+	if err != nil {
+		return err
+	}
+	defer out.abort()
+	if err := write(out); err != nil {
+		return err
+	}
+	return out.commit()
 }
@@ -2,21 +2,26 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
 	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
 
 	"pdf_wizard/models"
 )
 
 func TestPDFService_MergePDFs(t *testing.T) {
-	fileService := NewFileService(context.Background())
-	service := NewPDFService(fileService)
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -40,7 +45,7 @@ func TestPDFService_MergePDFs(t *testing.T) {
 	outputFilename := "merged"
 
 	// Test MergePDFs
-	err := service.MergePDFs([]string{pdf1, pdf2, pdf3}, outputDir, outputFilename)
+	_, err := service.MergePDFs(context.Background(), []string{pdf1, pdf2, pdf3}, outputDir, outputFilename, models.MergeOptions{}, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("MergePDFs failed: %v", err)
 	}
@@ -67,9 +72,165 @@ func TestPDFService_MergePDFs(t *testing.T) {
 	}
 }
 
+func TestPDFService_MergePDFPages(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	contract := filepath.Join(testDir, "contract.pdf")
+	appendix := filepath.Join(testDir, "appendix.pdf")
+	signature := filepath.Join(testDir, "signature.pdf")
+	for path, pages := range map[string]int{contract: 5, appendix: 2, signature: 3} {
+		if err := createNumberedTestPDF(path, pages); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+	}
+
+	inputs := []models.MergeInput{
+		{Path: contract, Pages: "1-2"},
+		{Path: appendix},
+		{Path: signature, Pages: "last"},
+		{Path: contract, Pages: "5,4"},
+	}
+	result, err := service.MergePDFPages(context.Background(), inputs, testDir, "packet", models.MergeOptions{}, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("MergePDFPages failed: %v", err)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Expected no warnings for different pages of the same file, got %v", result.Warnings)
+	}
+	if want, got := []int{1, 2, 1, 2, 3, 5, 4}, pageOrder(t, filepath.Join(testDir, "packet.pdf")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected pages %v, got %v", want, got)
+	}
+
+	// Ranges are validated against each input's own page count
+	inputs = []models.MergeInput{{Path: contract, Pages: "4-5"}, {Path: appendix, Pages: "3"}}
+	_, err = service.MergePDFPages(context.Background(), inputs, testDir, "invalid", models.MergeOptions{}, models.OutputOptions{})
+	if ErrorCodeOf(err) != ErrCodeMergePageRange {
+		t.Fatalf("Expected %s, got %v", ErrCodeMergePageRange, err)
+	}
+	if info := ToErrorInfo(err); info.Params["index"] != 2 || info.Params["filename"] != "appendix.pdf" {
+		t.Errorf("Expected the error to name input 2, got %+v", info.Params)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "invalid.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected no output for an invalid page range")
+	}
+}
+
+func TestPDFService_MergePDFs_Bookmarks(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	contract := filepath.Join(testDir, "contract.pdf")
+	if err := createTitledTestPDF(contract, 2, "Service Agreement"); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	// createMultiPageTestPDF merges single pages, so the file has an outline of its own
+	appendix := filepath.Join(testDir, "appendix.pdf")
+	if err := createMultiPageTestPDF(appendix, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	bookmarks := func(merge models.MergeOptions) []pdfcpu.Bookmark {
+		t.Helper()
+		if _, err := service.MergePDFs(context.Background(), []string{contract, appendix}, testDir, "merged", merge, models.OutputOptions{}); err != nil {
+			t.Fatalf("MergePDFs failed: %v", err)
+		}
+		f, err := os.Open(filepath.Join(testDir, "merged.pdf"))
+		if err != nil {
+			t.Fatalf("Failed to open merged PDF: %v", err)
+		}
+		defer f.Close()
+		bms, err := api.Bookmarks(f, nil)
+		if err != nil {
+			t.Fatalf("Failed to read bookmarks: %v", err)
+		}
+		return bms
+	}
+
+	if bms := bookmarks(models.MergeOptions{}); len(bms) != 0 {
+		t.Errorf("Expected no bookmarks by default, got %+v", bms)
+	}
+
+	bms := bookmarks(models.MergeOptions{Bookmarks: models.BookmarksFilename})
+	if len(bms) != 2 || bms[0].Title != "contract" || bms[0].PageFrom != 1 || bms[1].Title != "appendix" || bms[1].PageFrom != 3 {
+		t.Fatalf("Expected bookmarks contract (page 1) and appendix (page 3), got %+v", bms)
+	}
+	if len(bms[1].Kids) != 0 {
+		t.Errorf("Expected the appendix outline to be dropped, got %+v", bms[1].Kids)
+	}
+
+	// Inputs without a document title fall back to the filename
+	bms = bookmarks(models.MergeOptions{Bookmarks: models.BookmarksTitle, NestOutlines: true})
+	if len(bms) != 2 || bms[0].Title != "Service Agreement" || bms[1].Title != "appendix" {
+		t.Fatalf("Expected bookmarks Service Agreement and appendix, got %+v", bms)
+	}
+	if len(bms[1].Kids) != 2 || bms[1].Kids[0].PageFrom != 3 {
+		t.Errorf("Expected the appendix outline nested beneath its bookmark, got %+v", bms[1].Kids)
+	}
+
+	_, err := service.MergePDFs(context.Background(), []string{contract}, testDir, "invalid", models.MergeOptions{Bookmarks: "chapters"}, models.OutputOptions{})
+	if ErrorCodeOf(err) != ErrCodeBookmarksInvalid {
+		t.Errorf("Expected %s, got %v", ErrCodeBookmarksInvalid, err)
+	}
+}
+
+func TestPDFService_MergePDFs_BlankPages(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	var inputs []string
+	for i, pages := range []int{3, 2, 1} {
+		path := filepath.Join(testDir, fmt.Sprintf("input%d.pdf", i+1))
+		if err := createNumberedTestPDF(path, pages); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+		inputs = append(inputs, path)
+	}
+
+	// Blank pages have the size of the page before them
+	tests := []struct {
+		name  string
+		merge models.MergeOptions
+		want  []int
+	}{
+		{"duplex padding", models.MergeOptions{DuplexPadding: true}, []int{1, 2, 3, 3, 1, 2, 1}},
+		{"divider page", models.MergeOptions{DividerPage: true}, []int{1, 2, 3, 3, 1, 2, 2, 1}},
+		{"duplex padding with divider", models.MergeOptions{DuplexPadding: true, DividerPage: true}, []int{1, 2, 3, 3, 1, 2, 2, 2, 1}},
+	}
+	options := models.OutputOptions{ConflictPolicy: models.ConflictPolicyOverwrite}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.MergePDFs(context.Background(), inputs, testDir, "merged", tt.merge, options); err != nil {
+				t.Fatalf("MergePDFs failed: %v", err)
+			}
+			if got := pageOrder(t, filepath.Join(testDir, "merged.pdf")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected pages %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// Bookmarks point at the first page of each input, after any padding
+	merge := models.MergeOptions{DuplexPadding: true, Bookmarks: models.BookmarksFilename}
+	if _, err := service.MergePDFs(context.Background(), inputs, testDir, "merged", merge, options); err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+	f, err := os.Open(filepath.Join(testDir, "merged.pdf"))
+	if err != nil {
+		t.Fatalf("Failed to open merged PDF: %v", err)
+	}
+	defer f.Close()
+	bms, err := api.Bookmarks(f, nil)
+	if err != nil || len(bms) != 3 || bms[1].PageFrom != 5 || bms[2].PageFrom != 7 {
+		t.Errorf("Expected bookmarks at pages 1, 5 and 7, got %+v (%v)", bms, err)
+	}
+}
+
 func TestPDFService_SplitPDF(t *testing.T) {
-	fileService := NewFileService(context.Background())
-	service := NewPDFService(fileService)
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -93,7 +254,7 @@ func TestPDFService_SplitPDF(t *testing.T) {
 	}
 
 	// Test SplitPDF
-	err := service.SplitPDF(inputPDF, splits, outputDir)
+	_, err := service.SplitPDF(context.Background(), inputPDF, splits, outputDir, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("SplitPDF failed: %v", err)
 	}
@@ -161,8 +322,8 @@ func createMultiPageTestPDF(path string, numPages int) error {
 }
 
 func TestPDFService_RotatePDF(t *testing.T) {
-	fileService := NewFileService(context.Background())
-	service := NewPDFService(fileService)
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -184,7 +345,7 @@ func TestPDFService_RotatePDF(t *testing.T) {
 	}
 
 	// Test RotatePDF
-	err := service.RotatePDF(inputPDF, rotations, outputDir, outputFilename)
+	_, err := service.RotatePDF(context.Background(), inputPDF, rotations, outputDir, outputFilename, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("RotatePDF failed: %v", err)
 	}
@@ -212,8 +373,8 @@ func TestPDFService_RotatePDF(t *testing.T) {
 }
 
 func TestPDFService_ApplyWatermark(t *testing.T) {
-	fileService := NewFileService(context.Background())
-	service := NewPDFService(fileService)
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -242,7 +403,7 @@ func TestPDFService_ApplyWatermark(t *testing.T) {
 	}
 
 	// Test ApplyWatermark
-	err := service.ApplyWatermark(inputPDF, watermark, outputDir, outputFilename)
+	_, err := service.ApplyWatermark(context.Background(), inputPDF, watermark, outputDir, outputFilename, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("ApplyWatermark failed: %v", err)
 	}
@@ -270,8 +431,8 @@ func TestPDFService_ApplyWatermark(t *testing.T) {
 }
 
 func TestPDFService_ApplyWatermark_SpecificPages(t *testing.T) {
-	fileService := NewFileService(context.Background())
-	service := NewPDFService(fileService)
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -300,7 +461,7 @@ func TestPDFService_ApplyWatermark_SpecificPages(t *testing.T) {
 	}
 
 	// Test ApplyWatermark
-	err := service.ApplyWatermark(inputPDF, watermark, outputDir, outputFilename)
+	_, err := service.ApplyWatermark(context.Background(), inputPDF, watermark, outputDir, outputFilename, models.OutputOptions{})
 	if err != nil {
 		t.Fatalf("ApplyWatermark failed: %v", err)
 	}
@@ -328,8 +489,8 @@ func TestPDFService_ApplyWatermark_SpecificPages(t *testing.T) {
 }
 
 func TestPDFService_ApplyWatermark_Validation(t *testing.T) {
-	fileService := NewFileService(context.Background())
-	service := NewPDFService(fileService)
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
 
 	testDir := setupTestDir(t)
 	defer cleanupTestDir(t, testDir)
@@ -357,7 +518,7 @@ func TestPDFService_ApplyWatermark_Validation(t *testing.T) {
 		PageRange: "all",
 	}
 
-	err := service.ApplyWatermark(inputPDF, watermark, outputDir, outputFilename)
+	_, err := service.ApplyWatermark(context.Background(), inputPDF, watermark, outputDir, outputFilename, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for empty watermark text, got nil")
 	}
@@ -365,7 +526,7 @@ func TestPDFService_ApplyWatermark_Validation(t *testing.T) {
 	// Test with invalid page range
 	watermark.TextConfig.Text = "TEST"
 	watermark.PageRange = "999"
-	err = service.ApplyWatermark(inputPDF, watermark, outputDir, outputFilename)
+	_, err = service.ApplyWatermark(context.Background(), inputPDF, watermark, outputDir, outputFilename, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for invalid page range, got nil")
 	}
@@ -373,8 +534,303 @@ func TestPDFService_ApplyWatermark_Validation(t *testing.T) {
 	// Test with invalid opacity
 	watermark.PageRange = "all"
 	watermark.TextConfig.Opacity = 1.5
-	err = service.ApplyWatermark(inputPDF, watermark, outputDir, outputFilename)
+	_, err = service.ApplyWatermark(context.Background(), inputPDF, watermark, outputDir, outputFilename, models.OutputOptions{})
 	if err == nil {
 		t.Error("Expected error for invalid opacity, got nil")
 	}
 }
+
+func TestPDFService_ReportsProgress(t *testing.T) {
+	recorder := &progressRecorder{}
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), recorder)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 4); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	// Merge
+	if _, err := service.MergePDFs(context.Background(), []string{inputPDF, inputPDF}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{}); err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+	assertProgressSequence(t, recorder.Events(), OperationMerge)
+
+	// Split reports one writing step per split
+	recorder = &progressRecorder{}
+	service = NewPDFService(NewFileService(&FakeDialogProvider{}), recorder)
+	splits := []models.SplitDefinition{
+		{StartPage: 1, EndPage: 2, Filename: "part1"},
+		{StartPage: 3, EndPage: 4, Filename: "part2"},
+	}
+	if _, err := service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{}); err != nil {
+		t.Fatalf("SplitPDF failed: %v", err)
+	}
+	events := recorder.Events()
+	assertProgressSequence(t, events, OperationSplit)
+	writes := 0
+	for _, event := range events {
+		if event.Phase == PhaseWriting {
+			writes++
+			if event.Total != len(splits) {
+				t.Errorf("Expected total %d for writing phase, got %d", len(splits), event.Total)
+			}
+		}
+	}
+	if writes != len(splits) {
+		t.Errorf("Expected %d writing events, got %d", len(splits), writes)
+	}
+}
+
+func TestPDFService_MergePDFs_Cancelled(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf1 := filepath.Join(testDir, "test1.pdf")
+	pdf2 := filepath.Join(testDir, "test2.pdf")
+	for _, path := range []string{pdf1, pdf2} {
+		if err := createTestPDF(path); err != nil {
+			t.Fatalf("Failed to create test PDF: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := service.MergePDFs(ctx, []string{pdf1, pdf2}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "merged.pdf")); !os.IsNotExist(err) {
+		t.Error("Expected no output file after cancellation")
+	}
+}
+
+func TestPDFService_SplitPDF_CancelledRemovesPartialOutputs(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 6); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+	outputDir := filepath.Join(testDir, "output")
+	if err := os.Mkdir(outputDir, 0755); err != nil {
+		t.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	// Cancel as soon as the first split has been written
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reporter := ProgressReporterFunc(func(event models.ProgressEvent) {
+		if event.Phase == PhaseWriting && event.Current == 1 {
+			cancel()
+		}
+	})
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), reporter)
+
+	splits := []models.SplitDefinition{
+		{StartPage: 1, EndPage: 2, Filename: "part1"},
+		{StartPage: 3, EndPage: 4, Filename: "part2"},
+		{StartPage: 5, EndPage: 6, Filename: "part3"},
+	}
+	_, err := service.SplitPDF(ctx, inputPDF, splits, outputDir, models.OutputOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	entries, err := os.ReadDir(outputDir)
+	if err != nil {
+		t.Fatalf("Failed to read output directory: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected partial outputs to be removed, found %d files", len(entries))
+	}
+}
+
+func TestPDFService_RotatePDF_CancelledRemovesTempFile(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 4); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reporter := ProgressReporterFunc(func(event models.ProgressEvent) {
+		if event.Phase == PhaseProcessing && event.Current == 1 {
+			cancel()
+		}
+	})
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), reporter)
+
+	rotations := []models.RotateDefinition{
+		{StartPage: 1, EndPage: 2, Rotation: 90},
+		{StartPage: 3, EndPage: 4, Rotation: 180},
+	}
+	_, err := service.RotatePDF(ctx, inputPDF, rotations, testDir, "rotated", models.OutputOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	entries, err := os.ReadDir(testDir)
+	if err != nil {
+		t.Fatalf("Failed to read test directory: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != "input.pdf" {
+			t.Errorf("Expected %s to not exist after cancellation", entry.Name())
+		}
+	}
+}
+
+func TestPDFService_MergePDFs_Result(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	pdf1 := filepath.Join(testDir, "test1.pdf")
+	pdf2 := filepath.Join(testDir, "test2.pdf")
+	if err := createTestPDF(pdf1); err != nil {
+		t.Fatalf("Failed to create test PDF 1: %v", err)
+	}
+	if err := createMultiPageTestPDF(pdf2, 2); err != nil {
+		t.Fatalf("Failed to create test PDF 2: %v", err)
+	}
+
+	ctx := WithOperationID(context.Background(), "op-1")
+	result, err := service.MergePDFs(ctx, []string{pdf1, pdf2, pdf1}, testDir, "merged", models.MergeOptions{}, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("MergePDFs failed: %v", err)
+	}
+
+	if result.OperationID != "op-1" || result.Operation != OperationMerge {
+		t.Errorf("Unexpected result header: %+v", result)
+	}
+	if len(result.Outputs) != 1 {
+		t.Fatalf("Expected 1 output, got %d", len(result.Outputs))
+	}
+
+	output := result.Outputs[0]
+	outputPath := filepath.Join(testDir, "merged.pdf")
+	if output.Path != outputPath {
+		t.Errorf("Expected path %s, got %s", outputPath, output.Path)
+	}
+	if output.PageCount != 4 {
+		t.Errorf("Expected 4 pages, got %d", output.PageCount)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	if output.Size != int64(len(content)) {
+		t.Errorf("Expected size %d, got %d", len(content), output.Size)
+	}
+	sum := sha256.Sum256(content)
+	if output.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("SHA-256 mismatch: %s", output.SHA256)
+	}
+
+	// test1.pdf was given twice
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != string(WarnCodeDuplicateInput) || result.Warnings[0].Params["filename"] != "test1.pdf" {
+		t.Errorf("Expected 1 duplicate input warning, got %v", result.Warnings)
+	}
+}
+
+func TestPDFService_SplitPDF_Result(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 6); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	splits := []models.SplitDefinition{
+		{StartPage: 2, EndPage: 3, Filename: "first"},
+		{StartPage: 5, EndPage: 5, Filename: "second"},
+	}
+	result, err := service.SplitPDF(context.Background(), inputPDF, splits, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDF failed: %v", err)
+	}
+
+	expected := []struct {
+		name  string
+		pages int
+	}{{"first.pdf", 2}, {"second.pdf", 1}}
+	if len(result.Outputs) != len(expected) {
+		t.Fatalf("Expected %d outputs, got %d", len(expected), len(result.Outputs))
+	}
+	for i, e := range expected {
+		output := result.Outputs[i]
+		if output.Path != filepath.Join(testDir, e.name) || output.PageCount != e.pages {
+			t.Errorf("Output %d: expected %s with %d pages, got %+v", i, e.name, e.pages, output)
+		}
+		if output.Size == 0 || len(output.SHA256) != 64 {
+			t.Errorf("Output %d: missing size or hash: %+v", i, output)
+		}
+	}
+
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != string(WarnCodePagesNotInSplit) || result.Warnings[0].Message != "pages not included in any split: 1, 4, 6" {
+		t.Errorf("Unexpected warnings: %v", result.Warnings)
+	}
+}
+
+func TestPDFService_RotatePDF_OverlapWarning(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create multi-page test PDF: %v", err)
+	}
+
+	rotations := []models.RotateDefinition{
+		{StartPage: 1, EndPage: 2, Rotation: 90},
+		{StartPage: 2, EndPage: 3, Rotation: 90},
+	}
+	result, err := service.RotatePDF(context.Background(), inputPDF, rotations, testDir, "rotated", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("RotatePDF failed: %v", err)
+	}
+	if len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+		t.Errorf("Unexpected outputs: %+v", result.Outputs)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != string(WarnCodeRotationsOverlap) {
+		t.Errorf("Expected 1 overlap warning, got %v", result.Warnings)
+	}
+}
+
+func TestUncoveredPages(t *testing.T) {
+	tests := []struct {
+		splits     []models.SplitDefinition
+		totalPages int
+		want       []string
+	}{
+		{[]models.SplitDefinition{{StartPage: 1, EndPage: 5}}, 5, nil},
+		{[]models.SplitDefinition{{StartPage: 3, EndPage: 4}}, 8, []string{"1-2", "5-8"}},
+		{[]models.SplitDefinition{{StartPage: 1, EndPage: 1}, {StartPage: 3, EndPage: 3}}, 3, []string{"2"}},
+	}
+	for _, tt := range tests {
+		got := uncoveredPages(tt.splits, tt.totalPages)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("uncoveredPages(%v, %d) = %v, want %v", tt.splits, tt.totalPages, got, tt.want)
+		}
+	}
+}
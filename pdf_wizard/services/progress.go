@@ -0,0 +1,122 @@
+package services
+
+import (
+	"math"
+
+	"pdf_wizard/models"
+)
+
+// Operation names reported in progress events
+const (
+	OperationMerge       = "merge"
+	OperationSplit       = "split"
+	OperationRotate      = "rotate"
+	OperationWatermark   = "watermark"
+	OperationEncrypt     = "encrypt"
+	OperationDecrypt     = "decrypt"
+	OperationPermissions = "permissions"
+	OperationOptimize    = "optimize"
+	OperationExtract     = "extract"
+	OperationDelete      = "delete"
+	OperationReorder     = "reorder"
+	OperationInsert      = "insert"
+	OperationCollate     = "collate"
+)
+
+// Progress phases reported in progress events
+const (
+	PhaseValidating = "validating"
+	PhaseReading    = "reading"
+	PhaseMeasuring  = "measuring"
+	PhaseProcessing = "processing"
+	PhaseWriting    = "writing"
+	PhaseDone       = "done"
+)
+
+// ProgressReporter receives progress events from long-running PDF operations.
+// Implementations must be safe for concurrent use.
+type ProgressReporter interface {
+	ReportProgress(event models.ProgressEvent)
+}
+
+// ProgressReporterFunc adapts a function to the ProgressReporter interface
+type ProgressReporterFunc func(event models.ProgressEvent)
+
+// ReportProgress calls f(event)
+func (f ProgressReporterFunc) ReportProgress(event models.ProgressEvent) {
+	f(event)
+}
+
+// progressPhase is a phase of an operation and its share of the overall progress
+type progressPhase struct {
+	name   string
+	weight float64
+}
+
+// progressTracker converts per-phase item counts into ProgressEvents
+type progressTracker struct {
+	reporter    ProgressReporter
+	operationID string
+	operation   string
+	phases      []progressPhase
+}
+
+// newProgressTracker creates a tracker for one run of an operation.
+// phases are listed in execution order with their relative weights.
+func newProgressTracker(reporter ProgressReporter, operationID, operation string, phases ...progressPhase) *progressTracker {
+	return &progressTracker{
+		reporter:    reporter,
+		operationID: operationID,
+		operation:   operation,
+		phases:      phases,
+	}
+}
+
+// report emits an event for current of total items completed in phase
+func (t *progressTracker) report(phase string, current, total int) {
+	if t.reporter == nil {
+		return
+	}
+	t.reporter.ReportProgress(models.ProgressEvent{
+		OperationID: t.operationID,
+		Operation:   t.operation,
+		Phase:       phase,
+		Current:     current,
+		Total:       total,
+		Percent:     t.percent(phase, current, total),
+	})
+}
+
+// done emits the final event of a successful operation
+func (t *progressTracker) done() {
+	t.report(PhaseDone, 1, 1)
+}
+
+// percent computes the overall progress for current of total items in phase
+func (t *progressTracker) percent(phase string, current, total int) float64 {
+	if phase == PhaseDone {
+		return 100
+	}
+
+	var completed, sum, phaseWeight float64
+	found := false
+	for _, p := range t.phases {
+		sum += p.weight
+		if p.name == phase {
+			found = true
+			phaseWeight = p.weight
+		} else if !found {
+			completed += p.weight
+		}
+	}
+	if !found || sum == 0 {
+		return 0
+	}
+
+	fraction := 0.0
+	if total > 0 {
+		fraction = math.Min(float64(current)/float64(total), 1)
+	}
+	percent := (completed + phaseWeight*fraction) / sum * 100
+	return math.Round(percent*10) / 10
+}
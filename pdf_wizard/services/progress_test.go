@@ -0,0 +1,87 @@
+package services
+
+import (
+	"sync"
+	"testing"
+
+	"pdf_wizard/models"
+)
+
+// progressRecorder collects progress events for assertions
+type progressRecorder struct {
+	mu     sync.Mutex
+	events []models.ProgressEvent
+}
+
+func (r *progressRecorder) ReportProgress(event models.ProgressEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// Events returns a copy of the recorded events
+func (r *progressRecorder) Events() []models.ProgressEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]models.ProgressEvent(nil), r.events...)
+}
+
+// assertProgressSequence checks that events belong to one operation, never go
+// backwards and end with the done phase at 100%
+func assertProgressSequence(t *testing.T, events []models.ProgressEvent, operation string) {
+	t.Helper()
+	if len(events) == 0 {
+		t.Fatal("Expected progress events, got none")
+	}
+
+	operationID := events[0].OperationID
+	if operationID == "" {
+		t.Error("Expected non-empty operation ID")
+	}
+	last := -1.0
+	for _, event := range events {
+		if event.OperationID != operationID {
+			t.Errorf("Operation ID changed from %s to %s", operationID, event.OperationID)
+		}
+		if event.Operation != operation {
+			t.Errorf("Expected operation %s, got %s", operation, event.Operation)
+		}
+		if event.Percent < last {
+			t.Errorf("Progress went backwards: %.1f after %.1f (%s)", event.Percent, last, event.Phase)
+		}
+		last = event.Percent
+	}
+
+	final := events[len(events)-1]
+	if final.Phase != PhaseDone || final.Percent != 100 {
+		t.Errorf("Expected final event done at 100%%, got %s at %.1f", final.Phase, final.Percent)
+	}
+}
+
+func TestProgressTracker_Percent(t *testing.T) {
+	tracker := newProgressTracker(nil, "op", OperationMerge,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseReading, 30},
+		progressPhase{PhaseWriting, 60},
+	)
+
+	tests := []struct {
+		phase   string
+		current int
+		total   int
+		want    float64
+	}{
+		{PhaseValidating, 0, 4, 0},
+		{PhaseValidating, 2, 4, 5},
+		{PhaseReading, 3, 3, 40},
+		{PhaseWriting, 1, 2, 70},
+		{PhaseWriting, 0, 0, 40},
+		{PhaseDone, 1, 1, 100},
+		{"unknown", 1, 1, 0},
+	}
+	for _, tt := range tests {
+		if got := tracker.percent(tt.phase, tt.current, tt.total); got != tt.want {
+			t.Errorf("percent(%s, %d, %d) = %.1f, want %.1f", tt.phase, tt.current, tt.total, got, tt.want)
+		}
+	}
+}
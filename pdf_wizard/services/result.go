@@ -0,0 +1,152 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+
+	"pdf_wizard/models"
+)
+
+// WarningCode identifies a non-fatal issue independently of its (English)
+// message, like ErrorCode. Codes are stable; never rename one.
+type WarningCode string
+
+// Warning codes reported in OperationResult.Warnings
+const (
+	WarnCodeDuplicateInput     WarningCode = "DUPLICATE_INPUT"
+	WarnCodeCollateSameFile    WarningCode = "COLLATE_SAME_FILE"
+	WarnCodePagesNotInSplit    WarningCode = "PAGES_NOT_IN_SPLIT"
+	WarnCodeRotationsOverlap   WarningCode = "ROTATIONS_OVERLAP"
+	WarnCodeWatermarkInvisible WarningCode = "WATERMARK_INVISIBLE"
+	WarnCodeSamePasswords      WarningCode = "SAME_PASSWORDS"
+	WarnCodeNotSmaller         WarningCode = "OPTIMIZE_NOT_SMALLER"
+	WarnCodeOrderUnchanged     WarningCode = "PAGE_ORDER_UNCHANGED"
+)
+
+// warningMessages holds the English message template of every warning code;
+// {name} placeholders are replaced with the warning's params.
+// The frontend keeps the same templates in utils/i18n/errors/en.ts.
+var warningMessages = map[WarningCode]string{
+	WarnCodeDuplicateInput:     "{filename} is included more than once",
+	WarnCodeCollateSameFile:    "the fronts and backs are the same file",
+	WarnCodePagesNotInSplit:    "pages not included in any split: {pages}",
+	WarnCodeRotationsOverlap:   "rotations {first} and {second} overlap; overlapping pages are rotated by both",
+	WarnCodeWatermarkInvisible: "watermark opacity is 0; the watermark will not be visible",
+	WarnCodeSamePasswords:      "user and owner passwords are the same; anyone who can open the PDF can change its permissions",
+	WarnCodeNotSmaller:         "the optimized PDF is not smaller than the input",
+	WarnCodeOrderUnchanged:     "the page order is unchanged",
+}
+
+// resultBuilder collects the outputs and warnings of one operation run
+type resultBuilder struct {
+	result models.OperationResult
+	start  time.Time
+}
+
+// newResultBuilder starts timing a run of operation
+func newResultBuilder(operationID, operation string) *resultBuilder {
+	return &resultBuilder{
+		result: models.OperationResult{
+			OperationID: operationID,
+			Operation:   operation,
+			Outputs:     []models.OutputFile{},
+			Warnings:    []models.Warning{},
+			Skipped:     []string{},
+		},
+		start: time.Now(),
+	}
+}
+
+// addOutput records a written file
+func (b *resultBuilder) addOutput(path string) error {
+	return b.addOutputWithConfig(path, model.NewDefaultConfiguration())
+}
+
+// addOutputWithConfig records a written file that can only be read with conf,
+// e.g. one encrypted with a password
+func (b *resultBuilder) addOutputWithConfig(path string, conf *model.Configuration) error {
+	output, err := describeOutput(path, conf)
+	if err != nil {
+		return NewError(ErrCodeInspectOutput, ErrorParams{"path": path}, err)
+	}
+	b.result.Outputs = append(b.result.Outputs, output)
+	return nil
+}
+
+// addStagedOutput records an output that is staged but not committed yet,
+// reading it from its temporary file
+func (b *resultBuilder) addStagedOutput(out *outputFile) error {
+	output, err := describeOutput(out.tempPath, model.NewDefaultConfiguration())
+	if err != nil {
+		return NewError(ErrCodeInspectOutput, ErrorParams{"path": out.path}, err)
+	}
+	output.Path = out.path
+	b.result.Outputs = append(b.result.Outputs, output)
+	return nil
+}
+
+// reportSizes records the size before optimizing and the total size of the outputs
+// recorded so far, for operations that make files smaller
+func (b *resultBuilder) reportSizes(sizeBefore int64) {
+	b.result.SizeBefore = sizeBefore
+	b.result.SizeAfter = 0
+	for _, output := range b.result.Outputs {
+		b.result.SizeAfter += output.Size
+	}
+}
+
+// skip records an output that was not written because it already exists
+func (b *resultBuilder) skip(path string) {
+	b.result.Skipped = append(b.result.Skipped, path)
+}
+
+// warn records a non-fatal issue. params may be nil.
+func (b *resultBuilder) warn(code WarningCode, params ErrorParams) {
+	b.result.Warnings = append(b.result.Warnings, models.Warning{
+		Code:    string(code),
+		Message: fillParams(warningMessages[code], params),
+		Params:  map[string]interface{}(params),
+	})
+}
+
+// finish stops the timer and returns the result
+func (b *resultBuilder) finish() models.OperationResult {
+	b.result.ElapsedMs = time.Since(b.start).Milliseconds()
+	return b.result
+}
+
+// describeOutput reads the size, page count and SHA-256 of a written PDF
+func describeOutput(path string, conf *model.Configuration) (models.OutputFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return models.OutputFile{}, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return models.OutputFile{}, err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return models.OutputFile{}, err
+	}
+	pageCount, err := api.PageCount(f, conf)
+	if err != nil {
+		return models.OutputFile{}, err
+	}
+
+	return models.OutputFile{
+		Path:      path,
+		Size:      size,
+		PageCount: pageCount,
+		SHA256:    hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
@@ -0,0 +1,387 @@
+package services
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+
+	"pdf_wizard/models"
+)
+
+// EncryptPDF password-protects a PDF with AES encryption and restricts what
+// users who only know the user password may do with it.
+func (s *PDFService) EncryptPDF(ctx context.Context, inputPath string, encryption models.EncryptionDefinition, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationEncrypt,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseProcessing, 80},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationEncrypt)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// pdfcpu cannot encrypt a file twice
+	encrypted, err := s.fileService.isEncrypted(inputPath)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if encrypted {
+		return models.OperationResult{}, NewError(ErrCodeAlreadyEncrypted, ErrorParams{"path": inputPath}, nil)
+	}
+
+	// Validate encryption configuration
+	conf, err := encryptionConfiguration(encryption)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if encryption.UserPassword == encryption.OwnerPassword {
+		result.warn(WarnCodeSamePasswords, nil)
+	}
+
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodePDFRead, nil, err)
+	}
+	defer input.Close()
+
+	progress.report(PhaseProcessing, 0, 1)
+	err = writeOutput(outputPath, func(w io.Writer) error {
+		if err := api.Encrypt(input, w, conf); err != nil {
+			return NewError(ErrCodeEncryptFailed, nil, err)
+		}
+		progress.report(PhaseProcessing, 1, 1)
+		// The output is discarded if the operation was cancelled meanwhile
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		progress.report(PhaseWriting, 0, 1)
+		return nil
+	})
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// The output can only be inspected with the new passwords
+	if err := result.addOutputWithConfig(outputPath, passwordConfiguration(encryption.UserPassword, encryption.OwnerPassword)); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// DecryptPDF writes an unencrypted copy of a password-protected PDF. An empty
+// password uses the one stored with FileService.SetPDFPassword.
+func (s *PDFService) DecryptPDF(ctx context.Context, inputPath string, password string, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationDecrypt,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseProcessing, 80},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationDecrypt)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Check the password and that there is anything to decrypt
+	if password == "" {
+		password = s.fileService.password(inputPath)
+	}
+	pdfCtx, err := readContextWithPassword(inputPath, password)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if pdfCtx.Encrypt == nil {
+		return models.OperationResult{}, NewError(ErrCodeNotEncrypted, ErrorParams{"path": inputPath}, nil)
+	}
+
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodePDFRead, nil, err)
+	}
+	defer input.Close()
+
+	progress.report(PhaseProcessing, 0, 1)
+	err = writeOutput(outputPath, func(w io.Writer) error {
+		if err := api.Decrypt(input, w, passwordConfiguration(password, password)); err != nil {
+			return NewError(ErrCodeDecryptFailed, nil, err)
+		}
+		progress.report(PhaseProcessing, 1, 1)
+		// The output is discarded if the operation was cancelled meanwhile
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		progress.report(PhaseWriting, 0, 1)
+		return nil
+	})
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	if err := result.addOutput(outputPath); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// ChangePermissions rewrites the permission flags and/or the passwords of an
+// encrypted PDF. Both current passwords are needed, as with pdfcpu.
+func (s *PDFService) ChangePermissions(ctx context.Context, inputPath string, change models.PermissionChange, outputDirectory string, outputFilename string, options models.OutputOptions) (models.OperationResult, error) {
+	progress := s.startOperation(ctx, OperationPermissions,
+		progressPhase{PhaseValidating, 10},
+		progressPhase{PhaseProcessing, 80},
+		progressPhase{PhaseWriting, 10},
+	)
+	result := newResultBuilder(progress.operationID, OperationPermissions)
+	progress.report(PhaseValidating, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return models.OperationResult{}, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	// Validate output directory exists and is writable
+	if err := validateOutputDirectory(outputDirectory); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// Validate output filename
+	if strings.TrimSpace(outputFilename) == "" {
+		return models.OperationResult{}, NewError(ErrCodeOutputFilenameEmpty, nil, nil)
+	}
+
+	// Validate the change
+	conf, err := permissionChangeConfiguration(change)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	userPassword, ownerPassword := change.UserPassword, change.OwnerPassword
+	if change.NewUserPassword != nil {
+		userPassword = *change.NewUserPassword
+	}
+	if change.NewOwnerPassword != nil {
+		ownerPassword = *change.NewOwnerPassword
+	}
+	if userPassword == ownerPassword {
+		result.warn(WarnCodeSamePasswords, nil)
+	}
+
+	// Check the owner password and that the input is encrypted
+	pdfCtx, err := readContextWithPassword(inputPath, change.OwnerPassword)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if pdfCtx.Encrypt == nil {
+		return models.OperationResult{}, NewError(ErrCodeNotEncrypted, ErrorParams{"path": inputPath}, nil)
+	}
+
+	outputPath, skip, err := resolveOutputPath(filepath.Join(outputDirectory, outputFilename+PDFExtension), options.ConflictPolicy, nil)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	if skip {
+		// The existing file is kept (conflict policy "skip")
+		result.skip(outputPath)
+		progress.done()
+		return result.finish(), nil
+	}
+
+	progress.report(PhaseValidating, 1, 1)
+
+	if err := checkCancelled(ctx); err != nil {
+		return models.OperationResult{}, err
+	}
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return models.OperationResult{}, NewError(ErrCodePDFRead, nil, err)
+	}
+	defer input.Close()
+
+	progress.report(PhaseProcessing, 0, 1)
+	err = writeOutput(outputPath, func(w io.Writer) error {
+		// Both current passwords are only checked now
+		if err := api.Optimize(input, w, conf); err != nil {
+			if readErr := readError(inputPath, change.UserPassword, err); isPasswordError(readErr) {
+				return readErr
+			}
+			return NewError(ErrCodePermissionsFailed, nil, err)
+		}
+		progress.report(PhaseProcessing, 1, 1)
+		// The output is discarded if the operation was cancelled meanwhile
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		progress.report(PhaseWriting, 0, 1)
+		return nil
+	})
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+
+	// The output can only be inspected with the new passwords
+	if err := result.addOutputWithConfig(outputPath, passwordConfiguration(userPassword, ownerPassword)); err != nil {
+		return models.OperationResult{}, err
+	}
+
+	progress.done()
+	return result.finish(), nil
+}
+
+// permissionChangeConfiguration validates a permission change and converts it
+// into a pdfcpu configuration for api.Optimize
+func permissionChangeConfiguration(change models.PermissionChange) (*model.Configuration, error) {
+	if change.OwnerPassword == "" || (change.NewOwnerPassword != nil && *change.NewOwnerPassword == "") {
+		return nil, NewError(ErrCodeOwnerPasswordEmpty, nil, nil)
+	}
+	if change.Permissions == nil && change.NewUserPassword == nil && change.NewOwnerPassword == nil {
+		return nil, NewError(ErrCodeNoPermissionChange, nil, nil)
+	}
+
+	conf := passwordConfiguration(change.UserPassword, change.OwnerPassword)
+	// Password changes are written for any command that requires both passwords
+	conf.Cmd = model.CHANGEOPW
+	if change.Permissions != nil {
+		conf.Cmd = model.SETPERMISSIONS
+		conf.Permissions = permissionFlags(*change.Permissions)
+	}
+	conf.UserPWNew = change.NewUserPassword
+	conf.OwnerPWNew = change.NewOwnerPassword
+	return conf, nil
+}
+
+// encryptionConfiguration validates an encryption definition and converts it
+// into a pdfcpu configuration for api.Encrypt
+func encryptionConfiguration(encryption models.EncryptionDefinition) (*model.Configuration, error) {
+	// pdfcpu requires an owner password; the user password may be empty
+	if encryption.OwnerPassword == "" {
+		return nil, NewError(ErrCodeOwnerPasswordEmpty, nil, nil)
+	}
+
+	var keyLength int
+	switch encryption.Algorithm {
+	case "", models.EncryptionAES256:
+		keyLength = 256
+	case models.EncryptionAES128:
+		keyLength = 128
+	default:
+		return nil, NewError(ErrCodeEncryptionAlgorithm, ErrorParams{"algorithm": encryption.Algorithm}, nil)
+	}
+
+	conf := model.NewAESConfiguration(encryption.UserPassword, encryption.OwnerPassword, keyLength)
+	conf.Permissions = permissionFlags(encryption.Permissions)
+	return conf, nil
+}
+
+// permissionFlags converts permissions into pdfcpu's permission bits. Each
+// permission sets both its revision 2 and revision 3+ bits where they differ.
+func permissionFlags(permissions models.PDFPermissions) model.PermissionFlags {
+	flags := model.PermissionsNone
+	if permissions.Print {
+		flags |= model.PermissionPrintRev2 | model.PermissionPrintRev3
+	}
+	if permissions.Copy {
+		flags |= model.PermissionExtract | model.PermissionExtractRev3
+	}
+	if permissions.Modify {
+		flags |= model.PermissionModify | model.PermissionAssembleRev3
+	}
+	if permissions.Annotate {
+		flags |= model.PermissionModAnnFillForm | model.PermissionFillRev3
+	}
+	return flags
+}
+
+// passwordConfiguration returns a pdfcpu configuration for reading a PDF
+// protected with the given passwords
+func passwordConfiguration(userPassword, ownerPassword string) *model.Configuration {
+	conf := model.NewDefaultConfiguration()
+	conf.UserPW = userPassword
+	conf.OwnerPW = ownerPassword
+	return conf
+}
+
+// inspectInput returns the page count of an input PDF and whether it is
+// encrypted. Password errors are returned as is, so the caller can ask for the
+// password and retry.
+func (s *PDFService) inspectInput(path string) (int, bool, error) {
+	ctx, err := s.fileService.readContext(path)
+	if err != nil {
+		if isPasswordError(err) {
+			return 0, false, err
+		}
+		return 0, false, NewError(ErrCodePageCount, ErrorParams{"path": path}, err)
+	}
+	return ctx.PageCount, ctx.Encrypt != nil, nil
+}
+
+// removeEncryption makes pdfcpu write ctx without encryption
+func removeEncryption(ctx *model.Context) {
+	ctx.Encrypt = nil
+	ctx.EncKey = nil
+}
@@ -0,0 +1,376 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+
+	"pdf_wizard/models"
+)
+
+func TestPDFService_EncryptPDF(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	for _, algorithm := range []string{models.EncryptionAES128, models.EncryptionAES256} {
+		t.Run(algorithm, func(t *testing.T) {
+			encryption := models.EncryptionDefinition{
+				UserPassword:  "user",
+				OwnerPassword: "owner",
+				Algorithm:     algorithm,
+				Permissions:   models.PDFPermissions{Print: true},
+			}
+			result, err := service.EncryptPDF(context.Background(), inputPDF, encryption, testDir, algorithm, models.OutputOptions{})
+			if err != nil {
+				t.Fatalf("EncryptPDF failed: %v", err)
+			}
+			if len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+				t.Fatalf("Expected one 3-page output, got %+v", result.Outputs)
+			}
+
+			outputPath := filepath.Join(testDir, algorithm+".pdf")
+			if _, err := api.PageCountFile(outputPath); err == nil {
+				t.Error("Expected the encrypted PDF to require a password")
+			}
+
+			f, err := os.Open(outputPath)
+			if err != nil {
+				t.Fatalf("Failed to open output: %v", err)
+			}
+			defer f.Close()
+			permissions, err := api.GetPermissions(f, passwordConfiguration("user", "owner"))
+			if err != nil {
+				t.Fatalf("Failed to read permissions: %v", err)
+			}
+			if permissions == nil {
+				t.Fatal("Expected the output to be encrypted")
+			}
+			flags := model.PermissionFlags(uint16(*permissions))
+			if flags&model.PermissionPrintRev3 == 0 {
+				t.Error("Expected printing to be allowed")
+			}
+			if flags&model.PermissionExtract != 0 || flags&model.PermissionModify != 0 {
+				t.Error("Expected copying and modifying to be denied")
+			}
+		})
+	}
+}
+
+func TestPDFService_EncryptPDF_Validation(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createTestPDF(inputPDF); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		encryption models.EncryptionDefinition
+		code       ErrorCode
+	}{
+		{"missing owner password", models.EncryptionDefinition{UserPassword: "user"}, ErrCodeOwnerPasswordEmpty},
+		{"invalid algorithm", models.EncryptionDefinition{OwnerPassword: "owner", Algorithm: "rc4"}, ErrCodeEncryptionAlgorithm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.EncryptPDF(context.Background(), inputPDF, tt.encryption, testDir, "out", models.OutputOptions{})
+			if code := ErrorCodeOf(err); code != tt.code {
+				t.Errorf("Expected %s, got %s (%v)", tt.code, code, err)
+			}
+		})
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "out.pdf")); !os.IsNotExist(err) {
+		t.Error("No output should be written for an invalid encryption")
+	}
+}
+
+func TestPDFService_EncryptPDF_SamePasswordsWarns(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createTestPDF(inputPDF); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	encryption := models.EncryptionDefinition{UserPassword: "secret", OwnerPassword: "secret"}
+	result, err := service.EncryptPDF(context.Background(), inputPDF, encryption, testDir, "out", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("EncryptPDF failed: %v", err)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != string(WarnCodeSamePasswords) {
+		t.Errorf("Expected a warning about identical passwords, got %v", result.Warnings)
+	}
+}
+
+// createEncryptedTestPDF writes a PDF protected with the user password "user"
+// and the owner password "owner" that allows everything
+func createEncryptedTestPDF(path string, numPages int) error {
+	plain := path + ".plain"
+	if err := createMultiPageTestPDF(plain, numPages); err != nil {
+		return err
+	}
+	defer os.Remove(plain)
+
+	conf := model.NewAESConfiguration("user", "owner", 256)
+	conf.Permissions = model.PermissionsAll
+	return api.EncryptFile(plain, path, conf)
+}
+
+func TestPDFService_EncryptPDF_AlreadyEncrypted(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 1); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+	if err := fileService.SetPDFPassword(inputPDF, "owner"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	encryption := models.EncryptionDefinition{OwnerPassword: "other"}
+	_, err := service.EncryptPDF(context.Background(), inputPDF, encryption, testDir, "out", models.OutputOptions{})
+	if code := ErrorCodeOf(err); code != ErrCodeAlreadyEncrypted {
+		t.Errorf("Expected %s, got %s (%v)", ErrCodeAlreadyEncrypted, code, err)
+	}
+}
+
+func TestPDFService_EncryptedInput_RequiresDecrypt(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	lockedPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(lockedPDF, 2); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+	plainPDF := filepath.Join(testDir, "plain.pdf")
+	if err := createTestPDF(plainPDF); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	merge := func(options models.OutputOptions) (models.OperationResult, error) {
+		return service.MergePDFs(context.Background(), []string{lockedPDF, plainPDF}, testDir, "merged", models.MergeOptions{}, options)
+	}
+	split := func(options models.OutputOptions) (models.OperationResult, error) {
+		splits := []models.SplitDefinition{{StartPage: 1, EndPage: 1, Filename: "first"}}
+		return service.SplitPDF(context.Background(), lockedPDF, splits, testDir, options)
+	}
+
+	// Without a password the input cannot be opened
+	if _, err := merge(models.OutputOptions{}); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+		t.Fatalf("Expected %s, got %v", ErrCodePDFEncrypted, err)
+	}
+	if err := fileService.SetPDFPassword(lockedPDF, "user"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	for name, run := range map[string]func(models.OutputOptions) (models.OperationResult, error){"merge": merge, "split": split} {
+		t.Run(name, func(t *testing.T) {
+			// New documents would silently lose the protection
+			if _, err := run(models.OutputOptions{}); ErrorCodeOf(err) != ErrCodeDecryptRequired {
+				t.Fatalf("Expected %s, got %v", ErrCodeDecryptRequired, err)
+			}
+
+			result, err := run(models.OutputOptions{Decrypt: true})
+			if err != nil {
+				t.Fatalf("Operation failed: %v", err)
+			}
+			for _, output := range result.Outputs {
+				if _, err := api.PageCountFile(output.Path); err != nil {
+					t.Errorf("Expected %s to open without a password: %v", output.Path, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPDFService_RotatePDF_EncryptedInput(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+	if err := fileService.SetPDFPassword(inputPDF, "owner"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+
+	rotations := []models.RotateDefinition{{StartPage: 1, EndPage: 2, Rotation: 90}}
+
+	// The rotated document keeps its protection by default
+	result, err := service.RotatePDF(context.Background(), inputPDF, rotations, testDir, "protected", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("RotatePDF failed: %v", err)
+	}
+	if len(result.Outputs) != 1 || result.Outputs[0].PageCount != 2 {
+		t.Fatalf("Expected one 2-page output, got %+v", result.Outputs)
+	}
+	if _, err := api.PageCountFile(filepath.Join(testDir, "protected.pdf")); err == nil {
+		t.Error("Expected the rotated PDF to stay password protected")
+	}
+
+	// Decrypt removes it
+	if _, err := service.RotatePDF(context.Background(), inputPDF, rotations, testDir, "open", models.OutputOptions{Decrypt: true}); err != nil {
+		t.Fatalf("RotatePDF failed: %v", err)
+	}
+	if n, err := api.PageCountFile(filepath.Join(testDir, "open.pdf")); err != nil || n != 2 {
+		t.Errorf("Expected the decrypted PDF to open without a password, got %d pages (%v)", n, err)
+	}
+}
+
+func TestPDFService_DecryptPDF(t *testing.T) {
+	fileService := NewFileService(&FakeDialogProvider{})
+	service := NewPDFService(fileService, nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "locked.pdf")
+	if err := createEncryptedTestPDF(inputPDF, 3); err != nil {
+		t.Fatalf("Failed to create encrypted PDF: %v", err)
+	}
+
+	if _, err := service.DecryptPDF(context.Background(), inputPDF, "", testDir, "open", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+		t.Fatalf("Expected %s without a password, got %v", ErrCodePDFEncrypted, err)
+	}
+	if _, err := service.DecryptPDF(context.Background(), inputPDF, "wrong", testDir, "open", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodeIncorrectPassword {
+		t.Fatalf("Expected %s, got %v", ErrCodeIncorrectPassword, err)
+	}
+
+	result, err := service.DecryptPDF(context.Background(), inputPDF, "owner", testDir, "open", models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("DecryptPDF failed: %v", err)
+	}
+	if len(result.Outputs) != 1 || result.Outputs[0].PageCount != 3 {
+		t.Fatalf("Expected one 3-page output, got %+v", result.Outputs)
+	}
+	if n, err := api.PageCountFile(filepath.Join(testDir, "open.pdf")); err != nil || n != 3 {
+		t.Errorf("Expected the decrypted PDF to open without a password, got %d pages (%v)", n, err)
+	}
+
+	// The stored password is used when none is given
+	if err := fileService.SetPDFPassword(inputPDF, "user"); err != nil {
+		t.Fatalf("SetPDFPassword failed: %v", err)
+	}
+	if _, err := service.DecryptPDF(context.Background(), inputPDF, "", testDir, "stored", models.OutputOptions{}); err != nil {
+		t.Fatalf("DecryptPDF with the stored password failed: %v", err)
+	}
+
+	// There is nothing to decrypt in an unencrypted file
+	if _, err := service.DecryptPDF(context.Background(), filepath.Join(testDir, "open.pdf"), "", testDir, "again", models.OutputOptions{}); ErrorCodeOf(err) != ErrCodeNotEncrypted {
+		t.Errorf("Expected %s, got %v", ErrCodeNotEncrypted, err)
+	}
+}
+
+func TestPDFService_ChangePermissions(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "input.pdf")
+	if err := createMultiPageTestPDF(inputPDF, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	// Owner-restricted: opens without a password, but nothing is allowed
+	encryption := models.EncryptionDefinition{OwnerPassword: "owner"}
+	if _, err := service.EncryptPDF(context.Background(), inputPDF, encryption, testDir, "restricted", models.OutputOptions{}); err != nil {
+		t.Fatalf("EncryptPDF failed: %v", err)
+	}
+	restrictedPDF := filepath.Join(testDir, "restricted.pdf")
+
+	t.Run("permissions", func(t *testing.T) {
+		change := models.PermissionChange{OwnerPassword: "owner", Permissions: &models.PDFPermissions{Print: true, Modify: true}}
+		result, err := service.ChangePermissions(context.Background(), restrictedPDF, change, testDir, "editable", models.OutputOptions{})
+		if err != nil {
+			t.Fatalf("ChangePermissions failed: %v", err)
+		}
+		if len(result.Outputs) != 1 || result.Outputs[0].PageCount != 2 {
+			t.Fatalf("Expected one 2-page output, got %+v", result.Outputs)
+		}
+
+		f, err := os.Open(filepath.Join(testDir, "editable.pdf"))
+		if err != nil {
+			t.Fatalf("Failed to open output: %v", err)
+		}
+		defer f.Close()
+		permissions, err := api.GetPermissions(f, passwordConfiguration("", "owner"))
+		if err != nil || permissions == nil {
+			t.Fatalf("Failed to read permissions: %v", err)
+		}
+		flags := model.PermissionFlags(uint16(*permissions))
+		if flags&model.PermissionPrintRev3 == 0 || flags&model.PermissionModify == 0 {
+			t.Error("Expected printing and modifying to be allowed")
+		}
+		if flags&model.PermissionExtract != 0 {
+			t.Error("Expected copying to stay denied")
+		}
+	})
+
+	t.Run("passwords", func(t *testing.T) {
+		newUser, newOwner := "reader", "admin"
+		change := models.PermissionChange{OwnerPassword: "owner", NewUserPassword: &newUser, NewOwnerPassword: &newOwner}
+		if _, err := service.ChangePermissions(context.Background(), restrictedPDF, change, testDir, "rekeyed", models.OutputOptions{}); err != nil {
+			t.Fatalf("ChangePermissions failed: %v", err)
+		}
+		outputPath := filepath.Join(testDir, "rekeyed.pdf")
+		if _, err := readContextWithPassword(outputPath, ""); ErrorCodeOf(err) != ErrCodePDFEncrypted {
+			t.Errorf("Expected the output to need a password now, got %v", err)
+		}
+		for _, password := range []string{newUser, newOwner} {
+			if _, err := readContextWithPassword(outputPath, password); err != nil {
+				t.Errorf("Expected %q to open the output: %v", password, err)
+			}
+		}
+		if _, err := readContextWithPassword(outputPath, "owner"); ErrorCodeOf(err) != ErrCodeIncorrectPassword {
+			t.Errorf("Expected the old owner password to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		empty := ""
+		tests := []struct {
+			name   string
+			input  string
+			change models.PermissionChange
+			code   ErrorCode
+		}{
+			{"missing owner password", restrictedPDF, models.PermissionChange{Permissions: &models.PDFPermissions{}}, ErrCodeOwnerPasswordEmpty},
+			{"empty new owner password", restrictedPDF, models.PermissionChange{OwnerPassword: "owner", NewOwnerPassword: &empty}, ErrCodeOwnerPasswordEmpty},
+			{"nothing to change", restrictedPDF, models.PermissionChange{OwnerPassword: "owner"}, ErrCodeNoPermissionChange},
+			{"wrong owner password", restrictedPDF, models.PermissionChange{OwnerPassword: "nope", Permissions: &models.PDFPermissions{}}, ErrCodeIncorrectPassword},
+			{"not encrypted", inputPDF, models.PermissionChange{OwnerPassword: "owner", Permissions: &models.PDFPermissions{}}, ErrCodeNotEncrypted},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := service.ChangePermissions(context.Background(), tt.input, tt.change, testDir, "invalid", models.OutputOptions{})
+				if code := ErrorCodeOf(err); code != tt.code {
+					t.Errorf("Expected %s, got %s (%v)", tt.code, code, err)
+				}
+			})
+		}
+		if _, err := os.Stat(filepath.Join(testDir, "invalid.pdf")); !os.IsNotExist(err) {
+			t.Error("No output should be written for an invalid change")
+		}
+	})
+}
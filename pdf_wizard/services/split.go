@@ -0,0 +1,432 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+
+	"pdf_wizard/models"
+)
+
+// maxTitleLength caps the length of filenames made from bookmark titles
+const maxTitleLength = 100
+
+// maxPatternWidth caps the digits of a number width in a filename pattern,
+// so {n:99} is the widest number a pattern can ask for
+const maxPatternWidth = 2
+
+// reservedNames are device names Windows does not allow as a filename, with
+// or without an extension
+var reservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// GenerateSplitsEvery returns the split definitions that cut a PDF into
+// consecutive chunks of every.PagesPerFile pages; the last chunk holds the
+// remaining pages. The definitions can be edited before passing them to SplitPDF.
+func (s *PDFService) GenerateSplitsEvery(inputPath string, every models.SplitEveryDefinition) ([]models.SplitDefinition, error) {
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return nil, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	if every.PagesPerFile < 1 {
+		return nil, NewError(ErrCodeSplitPagesPerFile, ErrorParams{"count": every.PagesPerFile}, nil)
+	}
+
+	totalPages, _, err := s.inspectInput(inputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var chunks []pageSpan
+	for start := 1; start <= totalPages; start += every.PagesPerFile {
+		chunks = append(chunks, pageSpan{start: start, end: min(start+every.PagesPerFile-1, totalPages)})
+	}
+	pattern := every.FilenamePattern
+	if strings.TrimSpace(pattern) == "" {
+		pattern = models.DefaultSplitFilenamePattern
+	}
+	return namedSplits(inputPath, chunks, nil, pattern)
+}
+
+// SplitPDFEvery splits a PDF into chunks of a fixed page count, one file per
+// page if every.PagesPerFile is 1. It runs SplitPDF with the definitions of
+// GenerateSplitsEvery.
+func (s *PDFService) SplitPDFEvery(ctx context.Context, inputPath string, every models.SplitEveryDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	splits, err := s.GenerateSplitsEvery(inputPath, every)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	return s.SplitPDF(ctx, inputPath, splits, outputDirectory, options)
+}
+
+// GenerateSplitsByOutline returns one split definition per bookmark at
+// outline.Level, running from the bookmark's page to the page before the
+// next bookmark at that level or above. Pages before the first bookmark are
+// not included.
+func (s *PDFService) GenerateSplitsByOutline(inputPath string, outline models.SplitOutlineDefinition) ([]models.SplitDefinition, error) {
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return nil, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	if outline.Level < 1 {
+		return nil, NewError(ErrCodeOutlineLevel, ErrorParams{"level": outline.Level}, nil)
+	}
+
+	// pdfcpu only resolves bookmark destinations in a validated context
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+	pdfCtx, err := readValidatedContext(inputPath, config)
+	if err != nil {
+		return nil, readError(inputPath, config.UserPW, err)
+	}
+	bookmarks, err := pdfcpu.Bookmarks(pdfCtx)
+	if err != nil {
+		return nil, NewError(ErrCodeOutlineRead, ErrorParams{"path": inputPath}, err)
+	}
+
+	spans, titles := outlineSpans(bookmarks, outline.Level, pdfCtx.PageCount)
+	if len(spans) == 0 {
+		return nil, NewError(ErrCodeNoBookmarks, ErrorParams{"level": outline.Level}, nil)
+	}
+
+	pattern := outline.FilenamePattern
+	if strings.TrimSpace(pattern) == "" {
+		pattern = models.DefaultOutlineFilenamePattern
+	}
+	return namedSplits(inputPath, spans, titles, pattern)
+}
+
+// SplitPDFByOutline splits a PDF into one file per bookmark at an outline
+// level, e.g. one file per chapter. It runs SplitPDF with the definitions of
+// GenerateSplitsByOutline, so bookmarks with the same title fail with
+// DUPLICATE_FILENAME unless the pattern tells them apart.
+func (s *PDFService) SplitPDFByOutline(ctx context.Context, inputPath string, outline models.SplitOutlineDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	splits, err := s.GenerateSplitsByOutline(inputPath, outline)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	return s.SplitPDF(ctx, inputPath, splits, outputDirectory, options)
+}
+
+// GenerateSplitsBySize returns the split definitions that cut a PDF into as
+// few consecutive chunks as possible, each at most size.MaxBytes when written.
+// A page that alone exceeds the limit fails with SPLIT_PAGE_TOO_LARGE.
+// Chunks are measured by writing them in memory the way SplitPDF does, which
+// takes a while for large documents; cancelling ctx stops it.
+func (s *PDFService) GenerateSplitsBySize(ctx context.Context, inputPath string, size models.SplitSizeDefinition) ([]models.SplitDefinition, error) {
+	progress := s.startOperation(ctx, OperationSplit,
+		progressPhase{PhaseReading, 10},
+		progressPhase{PhaseMeasuring, 90},
+	)
+	splits, err := s.generateSplitsBySize(ctx, progress, inputPath, size)
+	if err != nil {
+		return nil, err
+	}
+	progress.done()
+	return splits, nil
+}
+
+// generateSplitsBySize runs GenerateSplitsBySize, reporting its reading and
+// measuring phases to progress. Measuring advances with the pages covered by
+// the chunks found so far.
+func (s *PDFService) generateSplitsBySize(ctx context.Context, progress *progressTracker, inputPath string, size models.SplitSizeDefinition) ([]models.SplitDefinition, error) {
+	progress.report(PhaseReading, 0, 1)
+
+	// Validate input file exists and is a PDF
+	if err := validatePDFFile(inputPath); err != nil {
+		return nil, NewError(ErrCodeInvalidInput, ErrorParams{"path": inputPath}, err)
+	}
+
+	if size.MaxBytes < 1 {
+		return nil, NewError(ErrCodeSplitMaxSize, ErrorParams{"maxBytes": size.MaxBytes}, nil)
+	}
+
+	// Read the input once, like api.Trim does for every split
+	config := s.fileService.withPassword(model.NewDefaultConfiguration(), inputPath)
+	config.Cmd = model.TRIM
+	pdfCtx, err := readOptimizedContext(inputPath, config)
+	if err != nil {
+		return nil, readError(inputPath, config.UserPW, err)
+	}
+	progress.report(PhaseReading, 1, 1)
+
+	var chunks []pageSpan
+	measure := func(start, end int) (int64, error) {
+		if err := checkCancelled(ctx); err != nil {
+			return 0, err
+		}
+		n, err := chunkSize(pdfCtx, start, end)
+		if err != nil {
+			return 0, NewError(ErrCodeSplitFailed, ErrorParams{"index": len(chunks) + 1, "startPage": start, "endPage": end}, err)
+		}
+		return n, nil
+	}
+	progress.report(PhaseMeasuring, 0, pdfCtx.PageCount)
+	for start := 1; start <= pdfCtx.PageCount; {
+		end, err := largestChunk(start, pdfCtx.PageCount, size.MaxBytes, measure)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, pageSpan{start: start, end: end})
+		progress.report(PhaseMeasuring, end, pdfCtx.PageCount)
+		start = end + 1
+	}
+
+	pattern := size.FilenamePattern
+	if strings.TrimSpace(pattern) == "" {
+		pattern = models.DefaultSplitFilenamePattern
+	}
+	return namedSplits(inputPath, chunks, nil, pattern)
+}
+
+// SplitPDFBySize splits a PDF into consecutive chunks that each fit in
+// size.MaxBytes, e.g. for an attachment limit. It runs SplitPDF with the
+// definitions of GenerateSplitsBySize, reporting both as one operation.
+func (s *PDFService) SplitPDFBySize(ctx context.Context, inputPath string, size models.SplitSizeDefinition, outputDirectory string, options models.OutputOptions) (models.OperationResult, error) {
+	// Measuring writes every chunk about log2(pages) times, so it outweighs the split itself
+	progress := s.startOperation(ctx, OperationSplit,
+		progressPhase{PhaseReading, 5},
+		progressPhase{PhaseMeasuring, 60},
+		progressPhase{PhaseValidating, 5},
+		progressPhase{PhaseWriting, 30},
+	)
+	splits, err := s.generateSplitsBySize(ctx, progress, inputPath, size)
+	if err != nil {
+		return models.OperationResult{}, err
+	}
+	return s.splitPDF(ctx, progress, inputPath, splits, outputDirectory, options)
+}
+
+// largestChunk returns the last page of the largest chunk starting at start
+// whose measured size is at most maxBytes. Sizes grow with the chunk, so the
+// chunk is doubled until it is too large and the end is then bisected.
+func largestChunk(start, totalPages int, maxBytes int64, measure func(start, end int) (int64, error)) (int, error) {
+	size, err := measure(start, start)
+	if err != nil {
+		return 0, err
+	}
+	if size > maxBytes {
+		return 0, NewError(ErrCodeSplitPageTooLarge, ErrorParams{"page": start, "size": size, "maxBytes": maxBytes}, nil)
+	}
+
+	fits, tooLarge := start, totalPages+1
+	for step := 1; fits < totalPages; step *= 2 {
+		end := min(start+step, totalPages)
+		size, err := measure(start, end)
+		if err != nil {
+			return 0, err
+		}
+		if size > maxBytes {
+			tooLarge = end
+			break
+		}
+		fits = end
+	}
+	for tooLarge-fits > 1 {
+		end := (fits + tooLarge) / 2
+		size, err := measure(start, end)
+		if err != nil {
+			return 0, err
+		}
+		if size > maxBytes {
+			tooLarge = end
+		} else {
+			fits = end
+		}
+	}
+	return fits, nil
+}
+
+// chunkSize returns the size of pages start-end of pdfCtx written as a new PDF
+func chunkSize(pdfCtx *model.Context, start, end int) (int64, error) {
+	pages := make([]int, 0, end-start+1)
+	for page := start; page <= end; page++ {
+		pages = append(pages, page)
+	}
+	chunk, err := pdfcpu.ExtractPages(pdfCtx, pages, false)
+	if err != nil {
+		return 0, err
+	}
+	var counter byteCounter
+	if err := api.WriteContext(chunk, &counter); err != nil {
+		return 0, err
+	}
+	return int64(counter), nil
+}
+
+// byteCounter is an io.Writer that only counts the bytes written to it
+type byteCounter int64
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	*c += byteCounter(len(p))
+	return len(p), nil
+}
+
+// outlineEntry is a bookmark with its outline level (1 for top-level bookmarks)
+type outlineEntry struct {
+	title string
+	page  int
+	level int
+}
+
+// flattenOutline appends the bookmarks down to maxLevel to entries, in outline order
+func flattenOutline(entries []outlineEntry, bookmarks []pdfcpu.Bookmark, level, maxLevel int) []outlineEntry {
+	for _, bookmark := range bookmarks {
+		entries = append(entries, outlineEntry{title: bookmark.Title, page: bookmark.PageFrom, level: level})
+		if level < maxLevel {
+			entries = flattenOutline(entries, bookmark.Kids, level+1, maxLevel)
+		}
+	}
+	return entries
+}
+
+// outlineSpans returns the page span and title of every bookmark at level.
+// A span ends before the next bookmark at level or above that starts on a
+// later page, so a chapter ends where the next chapter or part begins.
+func outlineSpans(bookmarks []pdfcpu.Bookmark, level, totalPages int) ([]pageSpan, []string) {
+	var entries []outlineEntry
+	for _, entry := range flattenOutline(nil, bookmarks, 1, level) {
+		// Bookmarks without a valid destination cannot start a split
+		if entry.page >= 1 && entry.page <= totalPages {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].page < entries[j].page })
+
+	var spans []pageSpan
+	var titles []string
+	for i, entry := range entries {
+		if entry.level != level {
+			continue
+		}
+		end := totalPages
+		for _, next := range entries[i+1:] {
+			if next.page > entry.page {
+				end = next.page - 1
+				break
+			}
+		}
+		spans = append(spans, pageSpan{start: entry.page, end: end})
+		titles = append(titles, entry.title)
+	}
+	return spans, titles
+}
+
+// sanitizeTitle turns a bookmark title into a filename that is valid on
+// Windows, macOS and Linux
+func sanitizeTitle(title string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsControl(r):
+			return ' '
+		case strings.ContainsRune(`<>:"/\|?*`, r):
+			return '_'
+		}
+		return r
+	}, title)
+	name = strings.Join(strings.Fields(name), " ")
+	if runes := []rune(name); len(runes) > maxTitleLength {
+		name = string(runes[:maxTitleLength])
+	}
+	// Windows drops trailing dots and spaces from filenames
+	name = strings.TrimRight(name, ". ")
+	if name == "" {
+		return "untitled"
+	}
+	// "CON" and "con.txt" both name a device on Windows; "CON_" is a file
+	base, ext, _ := strings.Cut(name, ".")
+	if reservedNames[strings.ToUpper(strings.TrimRight(base, " "))] {
+		name = strings.TrimRight(base, " ") + "_"
+		if ext != "" {
+			name += "." + ext
+		}
+	}
+	return name
+}
+
+// namedSplits turns page spans into split definitions named by pattern.
+// titles holds the bookmark title of each span for outline splits, nil otherwise.
+func namedSplits(inputPath string, spans []pageSpan, titles []string, pattern string) ([]models.SplitDefinition, error) {
+	name := strings.TrimSuffix(filepath.Base(inputPath), filepath.Ext(inputPath))
+
+	splits := make([]models.SplitDefinition, 0, len(spans))
+	for i, span := range spans {
+		values := map[string]interface{}{
+			"name":  name,
+			"n":     i + 1,
+			"start": span.start,
+			"end":   span.end,
+		}
+		if titles != nil {
+			values["title"] = sanitizeTitle(titles[i])
+		}
+		filename, err := expandFilenamePattern(pattern, values)
+		if err != nil {
+			return nil, err
+		}
+		splits = append(splits, models.SplitDefinition{StartPage: span.start, EndPage: span.end, Filename: filename})
+	}
+	return splits, nil
+}
+
+// expandFilenamePattern replaces the {key} placeholders of pattern with
+// values. Numbers may take a width, e.g. {n:03} writes 7 as "007". The
+// result must name a file in the output directory, not a path.
+func expandFilenamePattern(pattern string, values map[string]interface{}) (string, error) {
+	invalid := NewError(ErrCodeFilenamePattern, ErrorParams{"pattern": pattern}, nil)
+
+	var filename strings.Builder
+	rest := pattern
+	for {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			filename.WriteString(rest)
+			break
+		}
+		length := strings.IndexByte(rest[open:], '}')
+		if rest[open] == '}' || length < 0 {
+			return "", invalid
+		}
+		filename.WriteString(rest[:open])
+
+		key, width, hasWidth := strings.Cut(rest[open+1:open+length], ":")
+		switch value := values[key].(type) {
+		case int:
+			if !hasWidth {
+				fmt.Fprintf(&filename, "%d", value)
+				break
+			}
+			if width == "" || len(width) > maxPatternWidth || strings.Trim(width, "0123456789") != "" {
+				return "", invalid
+			}
+			fmt.Fprintf(&filename, "%"+width+"d", value)
+		case string:
+			if hasWidth {
+				return "", invalid
+			}
+			filename.WriteString(value)
+		default:
+			// Unknown placeholder
+			return "", invalid
+		}
+		rest = rest[open+length+1:]
+	}
+
+	// Backslashes are separators on Windows, so reject them everywhere
+	name := filename.String()
+	if strings.ContainsAny(name, `/\`) || name == "." || name == ".." || filepath.Base(name) != name {
+		return "", invalid
+	}
+	return name, nil
+}
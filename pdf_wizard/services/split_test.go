@@ -0,0 +1,413 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+
+	"pdf_wizard/models"
+)
+
+func TestPDFService_SplitPDFEvery(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "report.pdf")
+	if err := createNumberedTestPDF(inputPDF, 7); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// The last chunk holds the remaining pages
+	result, err := service.SplitPDFEvery(context.Background(), inputPDF, models.SplitEveryDefinition{PagesPerFile: 3}, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFEvery failed: %v", err)
+	}
+	if result.Operation != OperationSplit || len(result.Outputs) != 3 {
+		t.Fatalf("Expected 3 outputs, got %+v", result)
+	}
+	want := map[string][]int{
+		"report_001.pdf": {1, 2, 3},
+		"report_002.pdf": {4, 5, 6},
+		"report_003.pdf": {7},
+	}
+	for filename, pages := range want {
+		if got := pageOrder(t, filepath.Join(testDir, filename)); !reflect.DeepEqual(got, pages) {
+			t.Errorf("%s: expected pages %v, got %v", filename, pages, got)
+		}
+	}
+
+	// One file per page
+	result, err = service.SplitPDFEvery(context.Background(), inputPDF, models.SplitEveryDefinition{PagesPerFile: 1, FilenamePattern: "page-{start:02}"}, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFEvery failed: %v", err)
+	}
+	if len(result.Outputs) != 7 || filepath.Base(result.Outputs[0].Path) != "page-01.pdf" {
+		t.Fatalf("Expected 7 outputs starting with %q, got %+v", "page-01.pdf", result.Outputs)
+	}
+}
+
+func TestPDFService_GenerateSplitsEvery(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "scan.pdf")
+	if err := createNumberedTestPDF(inputPDF, 5); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	splits, err := service.GenerateSplitsEvery(inputPDF, models.SplitEveryDefinition{PagesPerFile: 2, FilenamePattern: "{name}-{n}-p{start}-{end}"})
+	if err != nil {
+		t.Fatalf("GenerateSplitsEvery failed: %v", err)
+	}
+	want := []models.SplitDefinition{
+		{StartPage: 1, EndPage: 2, Filename: "scan-1-p1-2"},
+		{StartPage: 3, EndPage: 4, Filename: "scan-2-p3-4"},
+		{StartPage: 5, EndPage: 5, Filename: "scan-3-p5-5"},
+	}
+	if !reflect.DeepEqual(splits, want) {
+		t.Errorf("Expected %+v, got %+v", want, splits)
+	}
+
+	tests := []struct {
+		name  string
+		every models.SplitEveryDefinition
+		code  ErrorCode
+	}{
+		{"zero pages per file", models.SplitEveryDefinition{PagesPerFile: 0}, ErrCodeSplitPagesPerFile},
+		{"unknown placeholder", models.SplitEveryDefinition{PagesPerFile: 1, FilenamePattern: "{title}"}, ErrCodeFilenamePattern},
+		{"constant pattern", models.SplitEveryDefinition{PagesPerFile: 1, FilenamePattern: "page"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GenerateSplitsEvery(inputPDF, tt.every)
+			if tt.code == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if ErrorCodeOf(err) != tt.code {
+				t.Fatalf("Expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+
+	// Generated names that collide are rejected by SplitPDF
+	_, err = service.SplitPDFEvery(context.Background(), inputPDF, models.SplitEveryDefinition{PagesPerFile: 1, FilenamePattern: "page"}, testDir, models.OutputOptions{})
+	if ErrorCodeOf(err) != ErrCodeDuplicateFilename {
+		t.Errorf("Expected %s, got %v", ErrCodeDuplicateFilename, err)
+	}
+}
+
+func TestExpandFilenamePattern(t *testing.T) {
+	values := map[string]interface{}{"name": "report", "n": 7}
+	tests := []struct {
+		pattern string
+		want    string
+		valid   bool
+	}{
+		{"{name}_{n:03}", "report_007", true},
+		{"{n}", "7", true},
+		{"chapter", "chapter", true},
+		{"{name:03}", "", false},
+		{"{n:x}", "", false},
+		{"{n", "", false},
+		{"n}", "", false},
+		{"{missing}", "", false},
+		{"{n:10}", "         7", true},
+		{"{n:100}", "", false},
+		{"{n:999999999}", "", false},
+		{"../{name}", "", false},
+		{"sub/{n}", "", false},
+		{`sub\{n}`, "", false},
+		{"..", "", false},
+		{".", "", false},
+	}
+	for _, tt := range tests {
+		got, err := expandFilenamePattern(tt.pattern, values)
+		if !tt.valid {
+			if ErrorCodeOf(err) != ErrCodeFilenamePattern {
+				t.Errorf("%q: expected %s, got %q, %v", tt.pattern, ErrCodeFilenamePattern, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%q: expected %q, got %q, %v", tt.pattern, tt.want, got, err)
+		}
+	}
+}
+
+// createOutlinedTestPDF creates a numbered test PDF (see createNumberedTestPDF)
+// with the given bookmarks
+func createOutlinedTestPDF(path string, numPages int, bookmarks []pdfcpu.Bookmark) error {
+	if err := createNumberedTestPDF(path, numPages); err != nil {
+		return err
+	}
+	return api.AddBookmarksFile(path, "", bookmarks, true, nil)
+}
+
+func TestPDFService_SplitPDFByOutline(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "report.pdf")
+	bookmarks := []pdfcpu.Bookmark{
+		{Title: "Part I", PageFrom: 2, Kids: []pdfcpu.Bookmark{
+			{Title: "Intro", PageFrom: 2},
+			{Title: "Chapter 1: Basics", PageFrom: 3},
+		}},
+		{Title: "Part II", PageFrom: 5, Kids: []pdfcpu.Bookmark{
+			{Title: "Chapter 2/3 ", PageFrom: 6},
+		}},
+	}
+	if err := createOutlinedTestPDF(inputPDF, 7, bookmarks); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// Page 1 comes before the first bookmark; a chapter ends where the next part begins
+	tests := []struct {
+		name    string
+		outline models.SplitOutlineDefinition
+		want    []models.SplitDefinition
+	}{
+		{"parts", models.SplitOutlineDefinition{Level: 1}, []models.SplitDefinition{
+			{StartPage: 2, EndPage: 4, Filename: "Part I"},
+			{StartPage: 5, EndPage: 7, Filename: "Part II"},
+		}},
+		{"chapters", models.SplitOutlineDefinition{Level: 2, FilenamePattern: "{n:02} {title}"}, []models.SplitDefinition{
+			{StartPage: 2, EndPage: 2, Filename: "01 Intro"},
+			{StartPage: 3, EndPage: 4, Filename: "02 Chapter 1_ Basics"},
+			{StartPage: 6, EndPage: 7, Filename: "03 Chapter 2_3"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			splits, err := service.GenerateSplitsByOutline(inputPDF, tt.outline)
+			if err != nil {
+				t.Fatalf("GenerateSplitsByOutline failed: %v", err)
+			}
+			if !reflect.DeepEqual(splits, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, splits)
+			}
+		})
+	}
+
+	result, err := service.SplitPDFByOutline(context.Background(), inputPDF, models.SplitOutlineDefinition{Level: 1}, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFByOutline failed: %v", err)
+	}
+	if result.Operation != OperationSplit || len(result.Outputs) != 2 || len(result.Warnings) != 1 {
+		t.Fatalf("Expected 2 outputs and a warning about page 1, got %+v", result)
+	}
+	if got := pageOrder(t, filepath.Join(testDir, "Part II.pdf")); !reflect.DeepEqual(got, []int{5, 6, 7}) {
+		t.Errorf("Expected pages [5 6 7], got %v", got)
+	}
+}
+
+func TestPDFService_SplitPDFByOutline_Validation(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "report.pdf")
+	bookmarks := []pdfcpu.Bookmark{{Title: "Summary", PageFrom: 1}, {Title: "Summary", PageFrom: 2}}
+	if err := createOutlinedTestPDF(inputPDF, 2, bookmarks); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+	plainPDF := filepath.Join(testDir, "plain.pdf")
+	if err := createNumberedTestPDF(plainPDF, 2); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		inputPath string
+		outline   models.SplitOutlineDefinition
+		code      ErrorCode
+	}{
+		{"level zero", inputPDF, models.SplitOutlineDefinition{Level: 0}, ErrCodeOutlineLevel},
+		{"no bookmarks", plainPDF, models.SplitOutlineDefinition{Level: 1}, ErrCodeNoBookmarks},
+		{"no bookmarks at level", inputPDF, models.SplitOutlineDefinition{Level: 2}, ErrCodeNoBookmarks},
+		{"duplicate titles", inputPDF, models.SplitOutlineDefinition{Level: 1}, ErrCodeDuplicateFilename},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.SplitPDFByOutline(context.Background(), tt.inputPath, tt.outline, testDir, models.OutputOptions{})
+			if ErrorCodeOf(err) != tt.code {
+				t.Fatalf("Expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+
+	// A pattern with the file number tells equal titles apart
+	outline := models.SplitOutlineDefinition{Level: 1, FilenamePattern: "{title} {n}"}
+	if _, err := service.SplitPDFByOutline(context.Background(), inputPDF, outline, testDir, models.OutputOptions{}); err != nil {
+		t.Errorf("SplitPDFByOutline failed: %v", err)
+	}
+}
+
+func TestSanitizeTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Chapter 1", "Chapter 1"},
+		{"Q&A: What/Why?", "Q&A_ What_Why_"},
+		{"  Line\nbreak\t ", "Line break"},
+		{"The end...", "The end"},
+		{"...", "untitled"},
+		{strings.Repeat("x", 150), strings.Repeat("x", maxTitleLength)},
+		{"Notes. . .", "Notes"},
+		{"CON", "CON_"},
+		{"nul", "nul_"},
+		{"Com1.txt", "Com1_.txt"},
+		{"LPT9 .", "LPT9_"},
+		{"AUX ", "AUX_"},
+		{"Console", "Console"},
+		{"COM10", "COM10"},
+	}
+	for _, tt := range tests {
+		if got := sanitizeTitle(tt.title); got != tt.want {
+			t.Errorf("sanitizeTitle(%q) = %q, expected %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestPDFService_SplitPDFBySize(t *testing.T) {
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), nil)
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "scan.pdf")
+	if err := createNumberedTestPDF(inputPDF, 9); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// Pick a limit that fits a few pages but not all of them
+	splits, err := service.GenerateSplitsEvery(inputPDF, models.SplitEveryDefinition{PagesPerFile: 3})
+	if err != nil {
+		t.Fatalf("GenerateSplitsEvery failed: %v", err)
+	}
+	result, err := service.SplitPDF(context.Background(), inputPDF, splits[:1], testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDF failed: %v", err)
+	}
+	maxBytes := result.Outputs[0].Size
+
+	size := models.SplitSizeDefinition{MaxBytes: maxBytes, FilenamePattern: "part{n}"}
+	result, err = service.SplitPDFBySize(context.Background(), inputPDF, size, testDir, models.OutputOptions{})
+	if err != nil {
+		t.Fatalf("SplitPDFBySize failed: %v", err)
+	}
+	if result.Operation != OperationSplit || len(result.Outputs) < 2 || len(result.Warnings) != 0 {
+		t.Fatalf("Expected several outputs covering every page, got %+v", result)
+	}
+	var pages []int
+	for i, output := range result.Outputs {
+		if output.Size > maxBytes {
+			t.Errorf("Output %d is %d bytes, more than %d", i+1, output.Size, maxBytes)
+		}
+		pages = append(pages, pageOrder(t, output.Path)...)
+	}
+	if !reflect.DeepEqual(pages, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("Expected the outputs to hold pages 1-9 in order, got %v", pages)
+	}
+	// The first chunk is as large as the limit allows
+	if result.Outputs[0].PageCount < 3 {
+		t.Errorf("Expected at least 3 pages in the first output, got %d", result.Outputs[0].PageCount)
+	}
+
+	_, err = service.GenerateSplitsBySize(context.Background(), inputPDF, models.SplitSizeDefinition{MaxBytes: 100})
+	if ErrorCodeOf(err) != ErrCodeSplitPageTooLarge {
+		t.Fatalf("Expected %s, got %v", ErrCodeSplitPageTooLarge, err)
+	}
+	if params := ToErrorInfo(err).Params; params["page"] != 1 || params["maxBytes"] != int64(100) {
+		t.Errorf("Expected page 1 and the limit in the error params, got %v", params)
+	}
+
+	_, err = service.GenerateSplitsBySize(context.Background(), inputPDF, models.SplitSizeDefinition{MaxBytes: 0})
+	if ErrorCodeOf(err) != ErrCodeSplitMaxSize {
+		t.Errorf("Expected %s, got %v", ErrCodeSplitMaxSize, err)
+	}
+}
+
+func TestPDFService_SplitPDFBySize_Progress(t *testing.T) {
+	testDir := setupTestDir(t)
+	defer cleanupTestDir(t, testDir)
+
+	inputPDF := filepath.Join(testDir, "scan.pdf")
+	if err := createNumberedTestPDF(inputPDF, 9); err != nil {
+		t.Fatalf("Failed to create test PDF: %v", err)
+	}
+
+	// Measuring and splitting report as one operation
+	recorder := &progressRecorder{}
+	service := NewPDFService(NewFileService(&FakeDialogProvider{}), recorder)
+	size := models.SplitSizeDefinition{MaxBytes: 1 << 30}
+	if _, err := service.SplitPDFBySize(context.Background(), inputPDF, size, testDir, models.OutputOptions{}); err != nil {
+		t.Fatalf("SplitPDFBySize failed: %v", err)
+	}
+	events := recorder.Events()
+	assertProgressSequence(t, events, OperationSplit)
+	measured := false
+	for _, event := range events {
+		if event.Phase == PhaseMeasuring && event.Current == 9 && event.Total == 9 {
+			measured = true
+			if event.Percent <= 0 || event.Percent >= 100 {
+				t.Errorf("Expected measuring to end part way, got %.1f%%", event.Percent)
+			}
+		}
+	}
+	if !measured {
+		t.Errorf("Expected a measuring event covering all 9 pages, got %+v", events)
+	}
+
+	// The preview reports measuring on its own
+	recorder = &progressRecorder{}
+	service = NewPDFService(NewFileService(&FakeDialogProvider{}), recorder)
+	if _, err := service.GenerateSplitsBySize(context.Background(), inputPDF, size); err != nil {
+		t.Fatalf("GenerateSplitsBySize failed: %v", err)
+	}
+	events = recorder.Events()
+	assertProgressSequence(t, events, OperationSplit)
+	if len(events) < 2 || events[len(events)-2].Phase != PhaseMeasuring {
+		t.Errorf("Expected measuring right before done, got %+v", events)
+	}
+}
+
+func TestLargestChunk(t *testing.T) {
+	// Pages of 10 bytes each
+	var calls int
+	measure := func(start, end int) (int64, error) {
+		calls++
+		return int64(end-start+1) * 10, nil
+	}
+	tests := []struct {
+		start, totalPages int
+		maxBytes          int64
+		want              int
+	}{
+		{1, 100, 10, 1},
+		{1, 100, 75, 7},
+		{5, 100, 1000, 100},
+		{98, 100, 25, 99},
+	}
+	for _, tt := range tests {
+		calls = 0
+		got, err := largestChunk(tt.start, tt.totalPages, tt.maxBytes, measure)
+		if err != nil || got != tt.want {
+			t.Errorf("largestChunk(%d, %d, %d) = %d, %v; expected %d", tt.start, tt.totalPages, tt.maxBytes, got, err, tt.want)
+		}
+		if calls > 16 {
+			t.Errorf("largestChunk(%d, %d, %d) measured %d chunks", tt.start, tt.totalPages, tt.maxBytes, calls)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package services
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,21 +14,21 @@ func isPDFFile(path string) bool {
 // validatePDFFile validates that a file exists and is a PDF
 func validatePDFFile(path string) error {
 	if path == "" {
-		return fmt.Errorf("file path cannot be empty")
+		return NewError(ErrCodeFilePathEmpty, nil, nil)
 	}
 
 	info, err := os.Stat(path)
 	if os.IsNotExist(err) {
-		return fmt.Errorf("file not found: %s", path)
+		return NewError(ErrCodeFileNotFound, ErrorParams{"path": path}, nil)
 	}
 	if err != nil {
-		return fmt.Errorf("error accessing file %s: %w", path, err)
+		return NewError(ErrCodeFileAccess, ErrorParams{"path": path}, err)
 	}
 	if info.IsDir() {
-		return fmt.Errorf("path is a directory, not a file: %s", path)
+		return NewError(ErrCodePathIsDirectory, ErrorParams{"path": path}, nil)
 	}
 	if !isPDFFile(path) {
-		return fmt.Errorf("file is not a PDF: %s", path)
+		return NewError(ErrCodeNotPDF, ErrorParams{"path": path}, nil)
 	}
 	return nil
 }
@@ -38,14 +37,13 @@ func validatePDFFile(path string) error {
 func validateOutputDirectory(path string) error {
 	info, err := os.Stat(path)
 	if os.IsNotExist(err) {
-		return fmt.Errorf("output directory does not exist: %s", path)
+		return NewError(ErrCodeOutputDirNotFound, ErrorParams{"path": path}, nil)
 	}
 	if err != nil {
-		return fmt.Errorf("error accessing output directory: %w", err)
+		return NewError(ErrCodeOutputDirAccess, ErrorParams{"path": path}, err)
 	}
 	if !info.IsDir() {
-		return fmt.Errorf("output path is not a directory: %s", path)
+		return NewError(ErrCodeOutputNotDirectory, ErrorParams{"path": path}, nil)
 	}
 	return nil
 }
-
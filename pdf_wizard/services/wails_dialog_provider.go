@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// WailsDialogProvider opens native dialogs through the Wails runtime
+type WailsDialogProvider struct {
+	ctx context.Context
+}
+
+// NewWailsDialogProvider creates a DialogProvider bound to the Wails app context
+func NewWailsDialogProvider(ctx context.Context) *WailsDialogProvider {
+	return &WailsDialogProvider{ctx: ctx}
+}
+
+// OpenFileDialog opens a single file selection dialog
+func (p *WailsDialogProvider) OpenFileDialog(options DialogOptions) (string, error) {
+	return runtime.OpenFileDialog(p.ctx, toRuntimeOptions(options))
+}
+
+// OpenMultipleFilesDialog opens a multiple file selection dialog
+func (p *WailsDialogProvider) OpenMultipleFilesDialog(options DialogOptions) ([]string, error) {
+	return runtime.OpenMultipleFilesDialog(p.ctx, toRuntimeOptions(options))
+}
+
+// OpenDirectoryDialog opens a directory selection dialog
+func (p *WailsDialogProvider) OpenDirectoryDialog(options DialogOptions) (string, error) {
+	return runtime.OpenDirectoryDialog(p.ctx, toRuntimeOptions(options))
+}
+
+// toRuntimeOptions converts DialogOptions to Wails runtime dialog options
+func toRuntimeOptions(options DialogOptions) runtime.OpenDialogOptions {
+	filters := make([]runtime.FileFilter, 0, len(options.Filters))
+	for _, filter := range options.Filters {
+		filters = append(filters, runtime.FileFilter{
+			DisplayName: filter.DisplayName,
+			Pattern:     filter.Pattern,
+		})
+	}
+	return runtime.OpenDialogOptions{
+		Title:   options.Title,
+		Filters: filters,
+	}
+}
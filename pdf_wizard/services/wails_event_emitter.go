@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// WailsEventEmitter emits events to the frontend through the Wails runtime
+type WailsEventEmitter struct {
+	ctx context.Context
+}
+
+// NewWailsEventEmitter creates an EventEmitter bound to the Wails app context.
+// The Wails runtime exits the process when events are emitted on a context it
+// did not create, so ctx must be the one passed to OnStartup.
+func NewWailsEventEmitter(ctx context.Context) *WailsEventEmitter {
+	return &WailsEventEmitter{ctx: ctx}
+}
+
+// Emit emits an event to the frontend
+func (e *WailsEventEmitter) Emit(name string, data ...interface{}) {
+	runtime.EventsEmit(e.ctx, name, data...)
+}